@@ -0,0 +1,132 @@
+package slang
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// WeightedTag is a language tag with its quality value from an Accept-Language header.
+type WeightedTag struct {
+	// Language tag or range as it appears in the header (example: fr-CH, *).
+	Tag string
+
+	// Quality value of the tag, in range of [0, 1].
+	Q float64
+}
+
+// ParseAcceptLanguage parses an Accept-Language header (example: "fr-CH, fr;q=0.9, en;q=0.8, *;q=0.5") into weighted tags.
+//
+// Result is sorted by quality value in descending order. Tags with the same quality value keep the order of the header.
+//
+// Quality value defaults to 1 when omitted, and is clamped to [0, 1].
+// Entries with malformed quality value (including NaN and infinity) or empty tag are skipped.
+//
+// If a tag contains characters other than ASCII letters, digits, dash (-), underscore (_) and asterisk (*),
+// it will return ErrInvalidAcceptLanguage.
+func ParseAcceptLanguage(header string) ([]WeightedTag, error) {
+	tags := []WeightedTag{}
+	for _, entry := range strings.Split(header, ",") {
+		params := strings.Split(entry, ";")
+		tag := strings.TrimSpace(params[0])
+		if tag == "" {
+			continue
+		}
+		if strings.IndexFunc(tag, isNotLanguageRangeChar) >= 0 {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidAcceptLanguage, tag)
+		}
+
+		q, ok := 1.0, true
+		for _, param := range params[1:] {
+			name, value, found := strings.Cut(strings.TrimSpace(param), "=")
+			if !found || !strings.EqualFold(strings.TrimSpace(name), "q") {
+				continue
+			}
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil || math.IsNaN(parsed) || math.IsInf(parsed, 0) {
+				ok = false
+				break
+			}
+			q = min(max(parsed, 0), 1)
+		}
+		if ok {
+			tags = append(tags, WeightedTag{Tag: tag, Q: q})
+		}
+	}
+
+	sort.SliceStable(tags, func(i, j int) bool {
+		return tags[i].Q > tags[j].Q
+	})
+	return tags, nil
+}
+
+func isNotLanguageRangeChar(c rune) bool {
+	return (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') && c != '-' && c != '_' && c != '*'
+}
+
+// Matcher negotiates the best supported language against an Accept-Language header.
+//
+// Matcher is safe for concurrent use by multiple goroutines.
+type Matcher struct {
+	parser    *LangParser
+	supported []string
+}
+
+// NewMatcher creates a Matcher for the given supported BCP47 tags.
+//
+// Tags are case insensitive and support both dash (-) and underscore (_) as separator.
+// The first supported tag is used when the header accepts any language (*).
+func (p *LangParser) NewMatcher(supported ...string) *Matcher {
+	m := &Matcher{parser: p}
+	for _, tag := range supported {
+		m.supported = append(m.supported, stdBCP47Tag(tag))
+	}
+	return m
+}
+
+// Match returns the best supported language for the Accept-Language header (example: "fr-CH, fr;q=0.9, en;q=0.8, *;q=0.5").
+//
+// Tags in the header are tried by quality value in descending order, and by header order when quality values are equal.
+// Tags with a quality value of 0 are ignored.
+// Each tag is resolved by FindAllByBCP47, so it falls back to its parent tags (example: fr-CH to fr),
+// and then to its descendant tags (example: fr to fr-FR).
+//
+// If the header is invalid, it will return the error from ParseAcceptLanguage.
+// If no supported language matches, it will return ErrNoMatch.
+func (m *Matcher) Match(acceptLanguage string) (*Lang, error) {
+	tags, err := ParseAcceptLanguage(acceptLanguage)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, wt := range tags {
+		if wt.Q == 0 {
+			continue
+		}
+		if wt.Tag == "*" {
+			for _, tag := range m.supported {
+				if lang := m.parser.FindByBCP47(tag); lang != nil {
+					return lang, nil
+				}
+			}
+			continue
+		}
+		for _, lang := range m.parser.FindAllByBCP47(wt.Tag) {
+			if m.isSupported(lang.BCP47) {
+				return &lang, nil
+			}
+		}
+	}
+	return nil, ErrNoMatch
+}
+
+func (m *Matcher) isSupported(tag string) bool {
+	for _, supported := range m.supported {
+		if supported == stdBCP47Tag(tag) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+package slang_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestMatcher(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	m := lp.NewMatcher("en-US", "fr", "de_DE", "zh-Hant")
+	for header, expected := range map[string]string{
+		"fr-CH, fr;q=0.9, en;q=0.8, *;q=0.5": "fr",
+		"de-DE":                              "de-DE",
+		"de;q=0.8, en-US;q=0.8":              "de-DE",
+		"ja, zh-Hant-TW;q=0.5":               "zh-Hant",
+		"en-GB, en;q=0.5":                    "en-US",
+		"ja, *;q=0.1":                        "en-US",
+		"fr;q=0, de;q=0.1":                   "de-DE",
+		"en-US;q=0.5, fr;q=0.5":              "en-US",
+		"fr;q=0.5, en-US;q=0.5":              "fr",
+		"en;q=NaN, fr;q=0.5, de;q=inf":       "fr",
+	} {
+		lang, err := m.Match(header)
+		if err != nil {
+			t.Errorf("Error: Match(%s): %v", header, err)
+			continue
+		}
+		if lang.BCP47 != expected {
+			t.Errorf("Error: Match(%s) should be '%s', got '%s'", header, expected, lang.BCP47)
+		}
+	}
+}
+
+func TestMatcherNoMatch(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	m := lp.NewMatcher("en-US", "fr")
+	for _, header := range []string{"", "ja, ko", "fr;q=0", "invalid"} {
+		if _, err := m.Match(header); !errors.Is(err, slang.ErrNoMatch) {
+			t.Errorf("Error: Match(%s) should return ErrNoMatch, got %v", header, err)
+		}
+	}
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tags, err := slang.ParseAcceptLanguage("fr-CH, fr;q=0.9, en;q=0.8, de;q=abc, *;q=0.5, ja;q=0.9, ko;q=1.5, ru;q=-1, , zh, es;q=NaN, it;q=inf, pt;q=-Inf")
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	expected := []slang.WeightedTag{
+		{Tag: "fr-CH", Q: 1},
+		{Tag: "ko", Q: 1},
+		{Tag: "zh", Q: 1},
+		{Tag: "fr", Q: 0.9},
+		{Tag: "ja", Q: 0.9},
+		{Tag: "en", Q: 0.8},
+		{Tag: "*", Q: 0.5},
+		{Tag: "ru", Q: 0},
+	}
+	if len(tags) != len(expected) {
+		t.Fatalf("Error: ParseAcceptLanguage should return %d tags, got %v", len(expected), tags)
+	}
+	for i := range expected {
+		if tags[i] != expected[i] {
+			t.Errorf("Error: ParseAcceptLanguage()[%d] should be %v, got %v", i, expected[i], tags[i])
+		}
+	}
+}
+
+func TestParseAcceptLanguageInvalid(t *testing.T) {
+	for _, header := range []string{"en US", "<script>", "fr;q=0.5, 中文"} {
+		if _, err := slang.ParseAcceptLanguage(header); !errors.Is(err, slang.ErrInvalidAcceptLanguage) {
+			t.Errorf("Error: ParseAcceptLanguage(%s) should return ErrInvalidAcceptLanguage, got %v", header, err)
+		}
+	}
+
+	tags, err := slang.ParseAcceptLanguage("")
+	if err != nil || len(tags) != 0 {
+		t.Errorf("Error: ParseAcceptLanguage() should return no tags and no error")
+	}
+}
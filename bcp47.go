@@ -0,0 +1,432 @@
+package slang
+
+import (
+	"fmt"
+	"strings"
+)
+
+// tagParts is a BCP47 tag split into subtags by their positions, in lower case.
+type tagParts struct {
+	language string
+	extlangs []string
+	script   string
+	region   string
+	variants []string
+}
+
+// splitTag splits the BCP47 tag following the positional rules of RFC 5646:
+// language, then optional extended language subtags, script, region and variants.
+//
+// Both dash (-) and underscore (_) are accepted as separator.
+// Splitting stops at the first subtag which does not fit into its position.
+func splitTag(tag string) tagParts {
+	subtags := strings.Split(stdBCP47Tag(tag), "-")
+	parts := tagParts{language: subtags[0]}
+	subtags = subtags[1:]
+
+	for len(parts.language) <= 3 && len(parts.extlangs) < 3 && len(subtags) > 0 && isExtlangSubtag(subtags[0]) {
+		parts.extlangs, subtags = append(parts.extlangs, subtags[0]), subtags[1:]
+	}
+	if len(subtags) > 0 && isScriptSubtag(subtags[0]) {
+		parts.script, subtags = subtags[0], subtags[1:]
+	}
+	if len(subtags) > 0 && isRegionSubtag(subtags[0]) {
+		parts.region, subtags = subtags[0], subtags[1:]
+	}
+	for len(subtags) > 0 && isVariantSubtag(subtags[0]) {
+		parts.variants, subtags = append(parts.variants, subtags[0]), subtags[1:]
+	}
+	return parts
+}
+
+// trimExtensions returns the tag in lower case with dash (-) as separator,
+// without extension and private use subtags (example: en-us for en-US-u-ca-gregory).
+//
+// A private use only tag (example: x-klingon) will return empty string.
+func trimExtensions(tag string) string {
+	subtags := strings.Split(stdBCP47Tag(tag), "-")
+	for i, subtag := range subtags {
+		if isSingletonSubtag(subtag) && (i > 0 || subtag == "x") {
+			return strings.Join(subtags[:i], "-")
+		}
+	}
+	return strings.Join(subtags, "-")
+}
+
+// trimWildcards returns the tag without wildcard (*) subtags after the language (example: en for en-*, en-us for en-*-us).
+//
+// A tag starting with a wildcard followed by other subtags (example: *-us) will return empty string.
+func trimWildcards(tag string) string {
+	subtags := strings.Split(tag, "-")
+	if subtags[0] == "*" && len(subtags) > 1 {
+		return ""
+	}
+	kept := subtags[:1]
+	for _, subtag := range subtags[1:] {
+		if subtag != "*" {
+			kept = append(kept, subtag)
+		}
+	}
+	return strings.Join(kept, "-")
+}
+
+// TagExtensions returns the extension and private use sequences of the BCP47 tag, in lower case.
+//
+// Keys are the singletons (example: "u" for Unicode locale extension, "x" for private use),
+// and values are the subtags following the singleton joined by dash (-).
+// Everything after "x" is private use, even if it looks like another singleton.
+//
+// Case insensitive, support both dash (-) and underscore (_) as separator.
+//
+// If the tag has no extension or private use subtags, it will return an empty map.
+//
+// # Examples
+//  1. "en-US-u-ca-gregory-x-custom" will return {"u": "ca-gregory", "x": "custom"}.
+//  2. "x-klingon" will return {"x": "klingon"}.
+//  3. "de-DE-x-phonebk-u-co" will return {"x": "phonebk-u-co"}.
+func TagExtensions(tag string) map[string]string {
+	extensions := map[string]string{}
+	singleton := ""
+	for i, subtag := range strings.Split(stdBCP47Tag(tag), "-") {
+		switch {
+		case subtag == "":
+			continue
+		case singleton != "x" && isSingletonSubtag(subtag) && (i > 0 || subtag == "x"):
+			singleton = subtag
+		case singleton == "":
+			continue
+		case extensions[singleton] == "":
+			extensions[singleton] = subtag
+		default:
+			extensions[singleton] += "-" + subtag
+		}
+	}
+	return extensions
+}
+
+// isSingletonSubtag checks if the subtag starts an extension or private use sequence.
+// The wildcard (*) of language ranges is a single character too, but it is not a singleton.
+func isSingletonSubtag(s string) bool {
+	return len(s) == 1 && s != "*"
+}
+
+func isExtlangSubtag(s string) bool {
+	return len(s) == 3 && isASCIIAlpha(s)
+}
+
+func isScriptSubtag(s string) bool {
+	return len(s) == 4 && isASCIIAlpha(s)
+}
+
+func isRegionSubtag(s string) bool {
+	return (len(s) == 2 && isASCIIAlpha(s)) || (len(s) == 3 && isASCIIDigit(s))
+}
+
+func isVariantSubtag(s string) bool {
+	switch {
+	case len(s) >= 5 && len(s) <= 8:
+		return isASCIIAlphaNum(s)
+	case len(s) == 4:
+		return isASCIIDigit(s[:1]) && isASCIIAlphaNum(s)
+	}
+	return false
+}
+
+// RegionOf returns the region subtag of the BCP47 tag in upper case, or empty string if there is none.
+//
+// The region is either a two-letter ISO 3166-1 code or a three-digit UN M49 code.
+//
+// # Examples
+//  1. "en-US" will return "US".
+//  2. "bho-Deva-IN" will return "IN".
+//  3. "es-419" will return "419".
+//  4. "zh" and "zh-Hans" will return "".
+func RegionOf(bcp47 string) string {
+	return strings.ToUpper(splitTag(bcp47).region)
+}
+
+// Region returns the region subtag of the BCP47 tag of the language. See RegionOf for details.
+func (lang Lang) Region() string {
+	return RegionOf(lang.BCP47)
+}
+
+// ScriptOf returns the script subtag of the BCP47 tag in title case, or empty string if there is none.
+//
+// Following positional rules of RFC 5646, the script is the four-letter subtag right after
+// the language (and extended language) subtags, so it can never be confused with a region or a variant.
+// A tag may have both script and region, such as "bho-Deva-IN", whose script is "Deva".
+//
+// # Examples
+//  1. "zh-Hans" will return "Hans".
+//  2. "bho-Deva-IN" will return "Deva".
+//  3. "zh-TW" and "de-1901" will return "".
+func ScriptOf(bcp47 string) string {
+	script := splitTag(bcp47).script
+	if script == "" {
+		return ""
+	}
+	return strings.ToUpper(script[:1]) + script[1:]
+}
+
+// Script returns the script subtag of the BCP47 tag of the language. See ScriptOf for details.
+func (lang Lang) Script() string {
+	return ScriptOf(lang.BCP47)
+}
+
+// VariantsOf returns the variant subtags of the BCP47 tag in lower case, in the order of the tag.
+//
+// Following positional rules of RFC 5646, variants are the subtags after the region (or the script or language,
+// if there is no region) which are 5 to 8 letters or digits, or 4 characters starting with a digit.
+// A tag may have multiple variants, such as "sl-rozaj-biske", and all of them are returned.
+// Extension and private use subtags (example: -u- and -x-) are never variants.
+//
+// # Examples
+//  1. "sl-rozaj" will return [rozaj].
+//  2. "ca-ES-valencia" will return [valencia].
+//  3. "de-CH-1901-x-custom" will return [1901].
+//  4. "en-US" will return an empty slice.
+func VariantsOf(bcp47 string) []string {
+	return append([]string{}, splitTag(bcp47).variants...)
+}
+
+// Variants returns the variant subtags of the BCP47 tag of the language. See VariantsOf for details.
+func (lang Lang) Variants() []string {
+	return VariantsOf(lang.BCP47)
+}
+
+// CanonicalizeBCP47 returns the BCP47 tag with canonical casing of RFC 5646 (example: zh-Hant-TW for ZH_hant_tw).
+//
+// Language is in lower case, script in title case, region in upper case, and other subtags in lower case.
+// Subtags after a singleton (example: -u- and -x-) are always in lower case.
+//
+// Both dash (-) and underscore (_) are accepted as separator, and the result always uses dash.
+// Extra separators and empty subtags are dropped (example: "-en--US-" will return "en-US").
+//
+// This only changes the format of the tag, and it does not check if the tag is valid.
+func CanonicalizeBCP47(tag string) string {
+	subtags := []string{}
+	afterSingleton := false
+	for _, subtag := range strings.Split(stdBCP47Tag(strings.TrimSpace(tag)), "-") {
+		switch {
+		case subtag == "":
+			continue
+		case len(subtags) == 0 || afterSingleton:
+			// Language and subtags after a singleton stay in lower case.
+		case len(subtag) == 2:
+			subtag = strings.ToUpper(subtag)
+		case len(subtag) == 4:
+			subtag = strings.ToUpper(subtag[:1]) + subtag[1:]
+		}
+		if isSingletonSubtag(subtag) {
+			afterSingleton = true
+		}
+		subtags = append(subtags, subtag)
+	}
+	return strings.Join(subtags, "-")
+}
+
+// ValidateBCP47 checks if the BCP47 tag is well-formed, following the structure of RFC 5646:
+//
+//	language ["-" script] ["-" region] *("-" variant) *("-" extension) ["-" privateuse]
+//
+// Language is 2-3 letters with up to three 3-letter extended language subtags, or 4-8 letters.
+// Script is 4 letters, region is 2 letters or 3 digits,
+// and variant is 5-8 alphanumerics or 4 alphanumerics starting with a digit.
+// Private use only tags (example: x-klingon) are also well-formed.
+//
+// Case insensitive, support both dash (-) and underscore (_) as separator.
+//
+// This is a structural check, it does not check if the tag is in the database or if the subtags are registered,
+// so "xx-Yyyy-ZZ" is well-formed.
+//
+// If the tag is malformed, it will return an error wrapping ErrInvalidBCP47 and naming the offending subtag.
+func ValidateBCP47(tag string) error {
+	subtags := strings.Split(strings.ReplaceAll(tag, "_", "-"), "-")
+	for _, subtag := range subtags {
+		if subtag == "" {
+			return fmt.Errorf("%w: empty subtag in %q", ErrInvalidBCP47, tag)
+		}
+		if len(subtag) > 8 || !isASCIIAlphaNum(subtag) {
+			return fmt.Errorf("%w: malformed subtag %q", ErrInvalidBCP47, subtag)
+		}
+	}
+
+	if !strings.EqualFold(subtags[0], "x") {
+		if len(subtags[0]) < 2 || !isASCIIAlpha(subtags[0]) {
+			return fmt.Errorf("%w: invalid language subtag %q", ErrInvalidBCP47, subtags[0])
+		}
+
+		parts := splitTag(tag)
+		subtags = subtags[1+len(parts.extlangs)+len(parts.variants):]
+		if parts.script != "" {
+			subtags = subtags[1:]
+		}
+		if parts.region != "" {
+			subtags = subtags[1:]
+		}
+	}
+
+	// Extensions and private use
+	for i := 0; i < len(subtags); {
+		singleton := strings.ToLower(subtags[i])
+		if len(singleton) != 1 {
+			return fmt.Errorf("%w: unexpected subtag %q", ErrInvalidBCP47, subtags[i])
+		}
+
+		i++
+		start := i
+		for i < len(subtags) && (singleton == "x" || len(subtags[i]) > 1) {
+			i++
+		}
+		if i == start {
+			return fmt.Errorf("%w: empty extension %q", ErrInvalidBCP47, subtags[start-1])
+		}
+	}
+	return nil
+}
+
+// IsWellFormedBCP47 checks if the BCP47 tag is well-formed. See ValidateBCP47 for details.
+func IsWellFormedBCP47(tag string) bool {
+	return ValidateBCP47(tag) == nil
+}
+
+// ParsedTag is a BCP47 tag decomposed into its subtags by DecomposeBCP47, with the casing of CanonicalizeBCP47.
+type ParsedTag struct {
+	// Primary language subtag in lower case (example: "zh"), or empty for private use only tags.
+	Language string
+
+	// Extended language subtags in lower case (example: ["yue"] for "zh-yue-HK").
+	ExtLangs []string
+
+	// Script subtag in title case (example: "Hant").
+	Script string
+
+	// Region subtag in upper case (example: "TW" or "419").
+	Region string
+
+	// Variant subtags in lower case, in the order of the tag (example: ["rozaj", "biske"]).
+	Variants []string
+
+	// Extension sequences in lower case including the singleton, in the order of the tag (example: ["u-ca-gregory"]).
+	Extensions []string
+
+	// Private use subtags after "x" in lower case, joined by dash (-) (example: "custom" for "en-US-x-custom").
+	PrivateUse string
+}
+
+// DecomposeBCP47 splits the BCP47 tag into its language, extended language, script, region, variant,
+// extension and private use subtags, following the positional rules of RFC 5646.
+//
+// Case insensitive, support both dash (-) and underscore (_) as separator.
+//
+// # Examples
+//  1. "zh-Hant-TW" will return {Language: "zh", Script: "Hant", Region: "TW"}.
+//  2. "sl-rozaj-biske" will return {Language: "sl", Variants: ["rozaj", "biske"]}.
+//  3. "en-US-u-ca-gregory-x-custom" will return {Language: "en", Region: "US", Extensions: ["u-ca-gregory"], PrivateUse: "custom"}.
+//  4. "x-klingon" will return {PrivateUse: "klingon"}.
+//
+// If the tag is malformed, it will return the subtags parsed before the offending one,
+// and an error wrapping ErrInvalidBCP47 (see ValidateBCP47).
+func DecomposeBCP47(tag string) (ParsedTag, error) {
+	err := ValidateBCP47(tag)
+	parsed := ParsedTag{}
+	subtags := strings.Split(stdBCP47Tag(tag), "-")
+
+	if subtags[0] != "x" {
+		if len(subtags[0]) < 2 || len(subtags[0]) > 8 || !isASCIIAlpha(subtags[0]) {
+			return parsed, err
+		}
+
+		parts := splitTag(tag)
+		parsed.Language = parts.language
+		parsed.ExtLangs = parts.extlangs
+		parsed.Region = strings.ToUpper(parts.region)
+		parsed.Variants = parts.variants
+		subtags = subtags[1+len(parts.extlangs)+len(parts.variants):]
+		if parts.script != "" {
+			parsed.Script = strings.ToUpper(parts.script[:1]) + parts.script[1:]
+			subtags = subtags[1:]
+		}
+		if parts.region != "" {
+			subtags = subtags[1:]
+		}
+	}
+
+	// Extensions and private use
+	for i := 0; i < len(subtags) && isSingletonSubtag(subtags[i]); {
+		singleton := subtags[i]
+		i++
+		start := i
+		for i < len(subtags) && (singleton == "x" || len(subtags[i]) > 1) {
+			i++
+		}
+		switch {
+		case i == start:
+			continue
+		case singleton == "x":
+			parsed.PrivateUse = strings.Join(subtags[start:i], "-")
+		default:
+			parsed.Extensions = append(parsed.Extensions, singleton+"-"+strings.Join(subtags[start:i], "-"))
+		}
+	}
+	return parsed, err
+}
+
+// String returns the BCP47 tag of the subtags, in the order of RFC 5646 and with the casing of CanonicalizeBCP47
+// (example: "zh-Hant-TW"), so components can be changed and serialized back.
+//
+// For a canonical tag, DecomposeBCP47 followed by String returns the same tag. Empty components are skipped,
+// and it does not check if the result is well-formed.
+func (t ParsedTag) String() string {
+	subtags := append([]string{t.Language}, t.ExtLangs...)
+	subtags = append(subtags, t.Script, t.Region)
+	subtags = append(subtags, t.Variants...)
+	subtags = append(subtags, t.Extensions...)
+	if t.PrivateUse != "" {
+		subtags = append(subtags, "x-"+t.PrivateUse)
+	}
+	return CanonicalizeBCP47(strings.Join(subtags, "-"))
+}
+
+// FallbackChain returns the tags to try in order when resolving the BCP47 tag, from the most specific to the least.
+//
+// The chain is built from the structure of the tag only by removing subtags from the end, so it works for any
+// tag even if it is not in the database. Tags in the chain are canonicalized by CanonicalizeBCP47.
+// Extension and private use sequences are removed as a whole with their singleton,
+// so a tag in the chain never ends with a singleton or a part of a sequence.
+//
+// # Examples
+//  1. "zh-Hant-TW" will return [zh-Hant-TW zh-Hant zh].
+//  2. "en_us" will return [en-US en].
+//  3. "en-US-x-custom" will return [en-US-x-custom en-US en].
+//  4. "en-US-u-ca-gregory" will return [en-US-u-ca-gregory en-US en].
+func FallbackChain(bcp47 string) []string {
+	chain := []string{}
+	subtags := strings.Split(CanonicalizeBCP47(bcp47), "-")
+
+	// Starts of extension and private use sequences. Everything after "x" is private use.
+	starts := []int{}
+	for i, subtag := range subtags {
+		if isSingletonSubtag(subtag) && (i > 0 || subtag == "x") {
+			starts = append(starts, i)
+			if subtag == "x" {
+				break
+			}
+		}
+	}
+
+	end := len(subtags)
+	for j := len(starts) - 1; j >= 0; j-- {
+		if end-1 != starts[j] {
+			chain = append(chain, strings.Join(subtags[:end], "-"))
+		}
+		end = starts[j]
+	}
+	for i := end; i > 0; i-- {
+		if len(subtags[i-1]) <= 1 {
+			continue
+		}
+		chain = append(chain, strings.Join(subtags[:i], "-"))
+	}
+	return chain
+}
@@ -0,0 +1,329 @@
+package slang_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestRegionOf(t *testing.T) {
+	for tag, expected := range map[string]string{
+		"en-US":        "US",
+		"zh-TW":        "TW",
+		"bho-Deva-IN":  "IN",
+		"zh":           "",
+		"zh-Hans":      "",
+		"es-419":       "419",
+		"en_us":        "US",
+		"es-ES_tradnl": "ES",
+		"sl-rozaj":     "",
+		"zh-yue-HK":    "HK",
+		"":             "",
+	} {
+		if region := slang.RegionOf(tag); region != expected {
+			t.Errorf("Error: RegionOf(%s) should be '%s', got '%s'", tag, expected, region)
+		}
+	}
+}
+
+func TestLangRegion(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	if region := lp.FindByBCP47("bho-Deva-IN").Region(); region != "IN" {
+		t.Errorf("Error: Region() of bho-Deva-IN should be 'IN', got '%s'", region)
+	}
+	if region := lp.FindByBCP47("zh").Region(); region != "" {
+		t.Errorf("Error: Region() of zh should be '', got '%s'", region)
+	}
+}
+
+func TestScriptOf(t *testing.T) {
+	for tag, expected := range map[string]string{
+		"zh-Hans":     "Hans",
+		"zh_hant_tw":  "Hant",
+		"bho-Deva-IN": "Deva",
+		"zh-TW":       "",
+		"zh":          "",
+		"de-1901":     "",
+		"sl-rozaj":    "",
+		"es-419":      "",
+		"zh-yue-Hant": "Hant",
+	} {
+		if script := slang.ScriptOf(tag); script != expected {
+			t.Errorf("Error: ScriptOf(%s) should be '%s', got '%s'", tag, expected, script)
+		}
+	}
+}
+
+func TestLangScript(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	if script := lp.FindByBCP47("bho-Deva-IN").Script(); script != "Deva" {
+		t.Errorf("Error: Script() of bho-Deva-IN should be 'Deva', got '%s'", script)
+	}
+	if script := lp.FindByBCP47("zh-CN").Script(); script != "" {
+		t.Errorf("Error: Script() of zh-CN should be '', got '%s'", script)
+	}
+}
+
+func TestVariantsOf(t *testing.T) {
+	for tag, expected := range map[string][]string{
+		"sl-rozaj":            {"rozaj"},
+		"sl-rozaj-biske":      {"rozaj", "biske"},
+		"de-1901":             {"1901"},
+		"de-CH-1901-x-custom": {"1901"},
+		"ca-ES-valencia":      {"valencia"},
+		"es-ES_tradnl":        {"tradnl"},
+		"en-US":               {},
+		"en-US-u-ca-gregory":  {},
+		"":                    {},
+	} {
+		if variants := slang.VariantsOf(tag); !reflect.DeepEqual(variants, expected) {
+			t.Errorf("Error: VariantsOf(%s) should be %v, got %v", tag, expected, variants)
+		}
+	}
+}
+
+func TestLangVariants(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	if variants := lp.FindByBCP47("ca-ES-valencia").Variants(); !reflect.DeepEqual(variants, []string{"valencia"}) {
+		t.Errorf("Error: Variants() of ca-ES-valencia should be [valencia], got %v", variants)
+	}
+	if variants := lp.FindByBCP47("en-US").Variants(); len(variants) != 0 {
+		t.Errorf("Error: Variants() of en-US should be empty, got %v", variants)
+	}
+}
+
+func TestCanonicalizeBCP47(t *testing.T) {
+	for tag, expected := range map[string]string{
+		"ZH_hant_tw":         "zh-Hant-TW",
+		"en-us":              "en-US",
+		"EN":                 "en",
+		"bho-deva-in":        "bho-Deva-IN",
+		"es-419":             "es-419",
+		"-en--US-":           "en-US",
+		"sl-ROZAJ":           "sl-rozaj",
+		"de-DE-1901":         "de-DE-1901",
+		"en-US-X-Custom-AB":  "en-US-x-custom-ab",
+		"de-de-U-CO-PHONEBK": "de-DE-u-co-phonebk",
+		"X-KLINGON":          "x-klingon",
+		"  zh_hans_cn  ":     "zh-Hans-CN",
+		"":                   "",
+		"es-ES_tradnl":       "es-ES-tradnl",
+		"sgn-BE-FR":          "sgn-BE-FR",
+		"zh-YUE-hk":          "zh-yue-HK",
+	} {
+		if canonical := slang.CanonicalizeBCP47(tag); canonical != expected {
+			t.Errorf("Error: CanonicalizeBCP47(%s) should be '%s', got '%s'", tag, expected, canonical)
+		}
+	}
+}
+
+func TestValidateBCP47(t *testing.T) {
+	for _, tag := range []string{
+		"en",
+		"en-US",
+		"zh-Hant-TW",
+		"bho-Deva-IN",
+		"es-419",
+		"xx-Yyyy-ZZ",
+		"sl-rozaj",
+		"sl-IT-rozaj-biske-1994",
+		"de-DE-1901",
+		"zh-yue-HK",
+		"en-US-x-custom",
+		"de-DE-u-co-phonebk",
+		"en-a-bbb-x-a-ccc",
+		"x-klingon",
+		"en_us",
+		"qps-ploc",
+		"es-ES_tradnl",
+	} {
+		if err := slang.ValidateBCP47(tag); err != nil {
+			t.Errorf("Error: ValidateBCP47(%s) should be nil, got %v", tag, err)
+		}
+	}
+}
+
+func TestValidateBCP47Invalid(t *testing.T) {
+	for tag, subtag := range map[string]string{
+		"":               `""`,
+		"e":              `"e"`,
+		"en--US":         `empty subtag`,
+		"en-US-":         `empty subtag`,
+		"1en":            `"1en"`,
+		"en-US-Latn":     `"Latn"`,
+		"en-abcdefghi":   `"abcdefghi"`,
+		"en-U$":          `"U$"`,
+		"en-US-u":        `"u"`,
+		"en-u-x-private": `"u"`,
+		"en-x":           `"x"`,
+		"zh-Hans-Hant":   `"Hant"`,
+		"en-US-a-bbb-c":  `"c"`,
+	} {
+		err := slang.ValidateBCP47(tag)
+		if !errors.Is(err, slang.ErrInvalidBCP47) {
+			t.Errorf("Error: ValidateBCP47(%s) should return ErrInvalidBCP47, got %v", tag, err)
+			continue
+		}
+		if !strings.Contains(err.Error(), subtag) {
+			t.Errorf("Error: ValidateBCP47(%s) error should contain %s, got %v", tag, subtag, err)
+		}
+	}
+}
+
+func TestIsWellFormedBCP47(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	for _, lang := range lp.Entries() {
+		if !slang.IsWellFormedBCP47(lang.BCP47) {
+			t.Errorf("Error: IsWellFormedBCP47(%s) should be true", lang.BCP47)
+		}
+	}
+	if slang.IsWellFormedBCP47("en-US-Latn") {
+		t.Errorf("Error: IsWellFormedBCP47(en-US-Latn) should be false")
+	}
+}
+
+func TestDecomposeBCP47(t *testing.T) {
+	for tag, expected := range map[string]slang.ParsedTag{
+		"zh_hant_tw":                  {Language: "zh", Script: "Hant", Region: "TW"},
+		"zh-yue-HK":                   {Language: "zh", ExtLangs: []string{"yue"}, Region: "HK"},
+		"sl-rozaj-biske":              {Language: "sl", Variants: []string{"rozaj", "biske"}},
+		"es-419":                      {Language: "es", Region: "419"},
+		"en-US-u-ca-gregory-x-custom": {Language: "en", Region: "US", Extensions: []string{"u-ca-gregory"}, PrivateUse: "custom"},
+		"de-DE-x-phonebk-u-co":        {Language: "de", Region: "DE", PrivateUse: "phonebk-u-co"},
+		"x-klingon":                   {PrivateUse: "klingon"},
+	} {
+		parsed, err := slang.DecomposeBCP47(tag)
+		if err != nil {
+			t.Errorf("Error: DecomposeBCP47(%s) should not return error, got %v", tag, err)
+		}
+		if !reflect.DeepEqual(parsed, expected) {
+			t.Errorf("Error: DecomposeBCP47(%s) should be %+v, got %+v", tag, expected, parsed)
+		}
+	}
+}
+
+func TestDecomposeBCP47Invalid(t *testing.T) {
+	for tag, expected := range map[string]slang.ParsedTag{
+		"en-US-toolongvariant": {Language: "en", Region: "US"},
+		"en-US-u":              {Language: "en", Region: "US"},
+		"en-Latn-US-Hans":      {Language: "en", Script: "Latn", Region: "US"},
+		"1-US":                 {},
+		"":                     {},
+	} {
+		parsed, err := slang.DecomposeBCP47(tag)
+		if !errors.Is(err, slang.ErrInvalidBCP47) {
+			t.Errorf("Error: DecomposeBCP47(%s) should return ErrInvalidBCP47, got %v", tag, err)
+		}
+		if !reflect.DeepEqual(parsed, expected) {
+			t.Errorf("Error: DecomposeBCP47(%s) should be %+v, got %+v", tag, expected, parsed)
+		}
+	}
+}
+
+func TestParsedTagString(t *testing.T) {
+	for _, tag := range []string{
+		"zh-Hant-TW",
+		"zh-yue-HK",
+		"sl-rozaj-biske",
+		"es-419",
+		"de-CH-1901",
+		"en-US-u-ca-gregory-x-custom",
+		"x-klingon",
+	} {
+		parsed, err := slang.DecomposeBCP47(tag)
+		if err != nil {
+			t.Errorf("Error: %v", err)
+		}
+		if result := parsed.String(); result != tag {
+			t.Errorf("Error: String() of DecomposeBCP47(%s) should be '%s', got '%s'", tag, tag, result)
+		}
+	}
+
+	parsed, _ := slang.DecomposeBCP47("zh-Hant-TW")
+	parsed.Region = "hk"
+	if result := parsed.String(); result != "zh-Hant-HK" {
+		t.Errorf("Error: String() of zh-Hant-TW with region hk should be 'zh-Hant-HK', got '%s'", result)
+	}
+	if result := (slang.ParsedTag{Language: "EN", Script: "latn"}).String(); result != "en-Latn" {
+		t.Errorf("Error: String() of {EN latn} should be 'en-Latn', got '%s'", result)
+	}
+}
+
+func TestFallbackChain(t *testing.T) {
+	for tag, expected := range map[string][]string{
+		"zh-Hant-TW":               {"zh-Hant-TW", "zh-Hant", "zh"},
+		"en_us":                    {"en-US", "en"},
+		"en":                       {"en"},
+		"xx-Yyyy-ZZ":               {"xx-Yyyy-ZZ", "xx-Yyyy", "xx"},
+		"en-US-x-custom":           {"en-US-x-custom", "en-US", "en"},
+		"x-klingon":                {"x-klingon"},
+		"en-US-u-ca-gregory":       {"en-US-u-ca-gregory", "en-US", "en"},
+		"en-US-u-ca-gregory-x-a-b": {"en-US-u-ca-gregory-x-a-b", "en-US-u-ca-gregory", "en-US", "en"},
+		"de-DE-x-phonebk-u-co":     {"de-DE-x-phonebk-u-co", "de-DE", "de"},
+		"en-US-u":                  {"en-US", "en"},
+		"":                         {},
+	} {
+		if chain := slang.FallbackChain(tag); !reflect.DeepEqual(chain, expected) {
+			t.Errorf("Error: FallbackChain(%s) should be %v, got %v", tag, expected, chain)
+		}
+	}
+}
+
+func TestTagExtensions(t *testing.T) {
+	tests := map[string]map[string]string{
+		"en-US-u-ca-gregory-x-custom": {"u": "ca-gregory", "x": "custom"},
+		"EN_us_U_CA_gregory":          {"u": "ca-gregory"},
+		"x-klingon":                   {"x": "klingon"},
+		"de-DE-x-phonebk-u-co":        {"x": "phonebk-u-co"},
+		"en-US":                       {},
+		"en-*-US":                     {},
+		"en-*-US-u-ca-gregory":        {"u": "ca-gregory"},
+		"i-klingon":                   {},
+		"":                            {},
+	}
+	for tag, expected := range tests {
+		if actual := slang.TagExtensions(tag); !reflect.DeepEqual(actual, expected) {
+			t.Errorf("Error: TagExtensions(%s) should be %v, got %v", tag, expected, actual)
+		}
+	}
+}
+
+func TestFindAllByBCP47Extensions(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	for tag, expected := range map[string][]string{
+		"en-US-x-custom":     {"en-US", "en"},
+		"en-US-u-ca-gregory": {"en-US", "en"},
+		"en-GB-x-a-b":        {"en-GB", "en"},
+		"x-klingon":          {},
+	} {
+		if actual := slang.Select(lp.FindAllByBCP47(tag), func(lang slang.Lang) string { return lang.BCP47 }); !reflect.DeepEqual(actual, expected) {
+			t.Errorf("Error: FindAllByBCP47(%s) should be %v, got %v", tag, expected, actual)
+		}
+	}
+	if lang := lp.Parse("en-US-x-custom"); lang == nil || lang.BCP47 != "en-US" {
+		t.Errorf("Error: Parse(en-US-x-custom) should be 'en-US'")
+	}
+}
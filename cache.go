@@ -0,0 +1,147 @@
+package slang
+
+import (
+	"container/list"
+	"sync"
+)
+
+// CacheStats is the counters of the parse cache enabled by WithCache.
+type CacheStats struct {
+	// Number of Parse calls answered by the cache.
+	Hits uint64
+
+	// Number of Parse calls not found in the cache.
+	Misses uint64
+
+	// Number of values currently in the cache.
+	Len int
+
+	// Maximum number of values in the cache, or 0 if the cache is disabled.
+	Size int
+}
+
+// parseCache is a bounded LRU cache of Parse results, keyed by the value to parse.
+//
+// It has its own lock, as lookups update the LRU order under the read lock of the parser.
+type parseCache struct {
+	mu     sync.Mutex
+	size   int
+	gen    uint64
+	items  map[string]*list.Element
+	order  *list.List
+	hits   uint64
+	misses uint64
+}
+
+type parseCacheEntry struct {
+	value string
+	lang  *Lang
+	kind  MatchKind
+}
+
+func newParseCache(size int) *parseCache {
+	return &parseCache{size: size, items: make(map[string]*list.Element), order: list.New()}
+}
+
+// get returns the cached result of the value.
+//
+// The generation returned must be passed to put, so results computed before a reset are not cached.
+func (c *parseCache) get(value string) (lang *Lang, kind MatchKind, gen uint64, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[value]
+	if !ok {
+		c.misses++
+		return nil, MatchNone, c.gen, false
+	}
+	c.hits++
+	c.order.MoveToFront(elem)
+	entry := elem.Value.(*parseCacheEntry)
+	return copyLang(entry.lang), entry.kind, c.gen, true
+}
+
+func (c *parseCache) put(value string, gen uint64, lang *Lang, kind MatchKind) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if gen != c.gen {
+		return
+	}
+	if elem, ok := c.items[value]; ok {
+		c.order.MoveToFront(elem)
+		elem.Value = &parseCacheEntry{value: value, lang: copyLang(lang), kind: kind}
+		return
+	}
+	c.items[value] = c.order.PushFront(&parseCacheEntry{value: value, lang: copyLang(lang), kind: kind})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*parseCacheEntry).value)
+	}
+}
+
+// reset drops all cached results, but keeps the counters.
+func (c *parseCache) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.gen++
+	c.items = make(map[string]*list.Element)
+	c.order.Init()
+}
+
+func (c *parseCache) stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return CacheStats{Hits: c.hits, Misses: c.misses, Len: c.order.Len(), Size: c.size}
+}
+
+// copyLang returns a copy of the language, so callers can not modify the cached one.
+func copyLang(lang *Lang) *Lang {
+	if lang == nil {
+		return nil
+	}
+	clone := *lang
+	return &clone
+}
+
+// WithCache enables a bounded LRU cache of Parse results, holding at most size values,
+// so repeated values (example: "en-US" in every request) skip the lookup.
+//
+// The cache is used by Parse, ParseE, ParseWithMatch and ParseMany, and is safe for concurrent use.
+// Values not found are cached too. The cache is cleared whenever languages or options of the parser change.
+// Calling it again replaces the cache, and a size less than 1 disables it.
+//
+// It is disabled by default, to avoid surprising memory use. See CacheStats for the hit and miss counters.
+func (p *LangParser) WithCache(size int) *LangParser {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.cache = nil
+	if size > 0 {
+		p.cache = newParseCache(size)
+	}
+	return p
+}
+
+// CacheStats returns the counters of the cache enabled by WithCache.
+//
+// If the cache is disabled, it will return zero counters.
+func (p *LangParser) CacheStats() CacheStats {
+	p.mu.RLock()
+	cache := p.cache
+	p.mu.RUnlock()
+	if cache == nil {
+		return CacheStats{}
+	}
+	return cache.stats()
+}
+
+// invalidate clears the cache after the languages or options change.
+//
+// invalidate must be called with p.mu held.
+func (p *LangParser) invalidate() {
+	if p.cache != nil {
+		p.cache.reset()
+	}
+}
@@ -0,0 +1,96 @@
+package slang_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestWithCache(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	lp.WithCache(2)
+
+	for i := 0; i < 3; i++ {
+		if lang := lp.Parse("en-US"); lang == nil || lang.BCP47 != "en-US" {
+			t.Errorf("Error: Parse(en-US) with cache should be 'en-US', got %v", lang)
+		}
+	}
+	if lang := lp.Parse("not-a-language"); lang != nil {
+		t.Errorf("Error: Parse(not-a-language) with cache should be nil, got %v", lang)
+	}
+	if lang := lp.Parse("not-a-language"); lang != nil {
+		t.Errorf("Error: cached Parse(not-a-language) should be nil, got %v", lang)
+	}
+	if stats := lp.CacheStats(); stats != (slang.CacheStats{Hits: 3, Misses: 2, Len: 2, Size: 2}) {
+		t.Errorf("Error: CacheStats() should be {3 2 2 2}, got %+v", stats)
+	}
+
+	lp.Parse("fr")
+	lp.Parse("de")
+	if stats := lp.CacheStats(); stats.Len != 2 {
+		t.Errorf("Error: cache should be bounded to 2 values, got %d", stats.Len)
+	}
+
+	lp.Parse("en-US").Name = "Modified"
+	if lang := lp.Parse("en-US"); lang.Name != "English" {
+		t.Errorf("Error: modifying a parsed language should not affect the cache, got %q", lang.Name)
+	}
+
+	if stats := lp.WithCache(0).CacheStats(); stats != (slang.CacheStats{}) {
+		t.Errorf("Error: CacheStats() of disabled cache should be zero, got %+v", stats)
+	}
+}
+
+func TestWithCacheInvalidate(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	lp.WithCache(16)
+
+	if lang := lp.Parse("kg-SU"); lang != nil {
+		t.Errorf("Error: Parse(kg-SU) should be nil before AddCustom, got %v", lang)
+	}
+	lp.AddCustom(slang.Lang{Name: "Klingon", BCP47: "kg-SU", WinID: "KLI"})
+	if lang := lp.Parse("kg-SU"); lang == nil || lang.Name != "Klingon" {
+		t.Errorf("Error: Parse(kg-SU) should find 'Klingon' after AddCustom, got %v", lang)
+	}
+
+	if lang := lp.Parse("KLI"); lang == nil || lang.Name != "Klingon" {
+		t.Errorf("Error: Parse(KLI) should be 'Klingon', got %v", lang)
+	}
+	lp.WithParseOrder(slang.MatchBCP47)
+	if lang := lp.Parse("KLI"); lang != nil {
+		t.Errorf("Error: Parse(KLI) should be nil after WithParseOrder(MatchBCP47), got %v", lang)
+	}
+
+	if stats := lp.Clone().CacheStats(); stats != (slang.CacheStats{Size: 16}) {
+		t.Errorf("Error: CacheStats() of clone should be empty, got %+v", stats)
+	}
+}
+
+func TestWithCacheConcurrent(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	lp.WithCache(4)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, code := range []string{"en-US", "fr", "zh-CN", "de", "ja", "en-US"} {
+				if lp.Parse(code) == nil {
+					t.Errorf("Error: Parse(%s) with cache should not be nil", code)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,45 @@
+package slang
+
+import "time"
+
+// firstDays maps lower-cased ISO 3166-1 alpha-2 codes to the first day of the week, following CLDR.
+// Regions not in the table start the week on Monday.
+//
+// See: https://www.unicode.org/cldr/charts/latest/supplemental/territory_information.html
+var firstDays = map[string]time.Weekday{
+	// Sunday
+	"ag": time.Sunday, "as": time.Sunday, "bd": time.Sunday, "br": time.Sunday, "bs": time.Sunday,
+	"bt": time.Sunday, "bw": time.Sunday, "bz": time.Sunday, "ca": time.Sunday, "cn": time.Sunday,
+	"co": time.Sunday, "dm": time.Sunday, "do": time.Sunday, "et": time.Sunday, "gt": time.Sunday,
+	"gu": time.Sunday, "hk": time.Sunday, "hn": time.Sunday, "id": time.Sunday, "il": time.Sunday,
+	"in": time.Sunday, "jm": time.Sunday, "jp": time.Sunday, "ke": time.Sunday, "kh": time.Sunday,
+	"kr": time.Sunday, "la": time.Sunday, "mh": time.Sunday, "mm": time.Sunday, "mo": time.Sunday,
+	"mt": time.Sunday, "mx": time.Sunday, "mz": time.Sunday, "ni": time.Sunday, "np": time.Sunday,
+	"pa": time.Sunday, "pe": time.Sunday, "ph": time.Sunday, "pk": time.Sunday, "pr": time.Sunday,
+	"pt": time.Sunday, "py": time.Sunday, "sa": time.Sunday, "sg": time.Sunday, "sv": time.Sunday,
+	"th": time.Sunday, "tt": time.Sunday, "tw": time.Sunday, "um": time.Sunday, "us": time.Sunday,
+	"ve": time.Sunday, "vi": time.Sunday, "ws": time.Sunday, "ye": time.Sunday, "za": time.Sunday,
+	"zw": time.Sunday,
+
+	// Saturday
+	"ae": time.Saturday, "af": time.Saturday, "bh": time.Saturday, "dj": time.Saturday, "dz": time.Saturday,
+	"eg": time.Saturday, "iq": time.Saturday, "ir": time.Saturday, "jo": time.Saturday, "kw": time.Saturday,
+	"ly": time.Saturday, "om": time.Saturday, "qa": time.Saturday, "sd": time.Saturday, "sy": time.Saturday,
+
+	// Friday
+	"mv": time.Friday,
+}
+
+// FirstDayOfWeek returns the first day of the week in the region, such as Sunday for US, Monday for most of Europe,
+// and Saturday for much of the Middle East.
+//
+// The region can be an ISO 3166-1 alpha-2, alpha-3 or numeric code (example: "US", "USA" or "840"), case insensitive.
+// Use RegionOf to get the region of a BCP47 tag.
+//
+// If the region is unknown, it will return Monday, following ISO 8601.
+func FirstDayOfWeek(region string) time.Weekday {
+	if day, ok := firstDays[toLowerASCII(CountryAlpha2(region))]; ok {
+		return day
+	}
+	return time.Monday
+}
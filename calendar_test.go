@@ -0,0 +1,32 @@
+package slang_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestFirstDayOfWeek(t *testing.T) {
+	for region, expected := range map[string]time.Weekday{
+		"US":  time.Sunday,
+		"us":  time.Sunday,
+		"USA": time.Sunday,
+		"840": time.Sunday,
+		"DE":  time.Monday,
+		"FR":  time.Monday,
+		"EG":  time.Saturday,
+		"MV":  time.Friday,
+		"419": time.Monday,
+		"ZZ":  time.Monday,
+		"":    time.Monday,
+	} {
+		if day := slang.FirstDayOfWeek(region); day != expected {
+			t.Errorf("Error: FirstDayOfWeek(%s) should be %v, got %v", region, expected, day)
+		}
+	}
+
+	if day := slang.FirstDayOfWeek(slang.RegionOf("ar-SA")); day != time.Sunday {
+		t.Errorf("Error: FirstDayOfWeek of ar-SA should be Sunday, got %v", day)
+	}
+}
@@ -0,0 +1,305 @@
+package slang
+
+import "strings"
+
+// country is an entry of the ISO 3166-1 table.
+type country struct {
+	alpha3  string
+	numeric string
+	name    string
+}
+
+// countries is the ISO 3166-1 table keyed by the alpha-2 codes in lower case, using the common names of the countries.
+//
+// See: https://www.iso.org/iso-3166-country-codes.html
+var countries = map[string]country{
+	"ad": {"AND", "020", "Andorra"},
+	"ae": {"ARE", "784", "United Arab Emirates"},
+	"af": {"AFG", "004", "Afghanistan"},
+	"ag": {"ATG", "028", "Antigua and Barbuda"},
+	"ai": {"AIA", "660", "Anguilla"},
+	"al": {"ALB", "008", "Albania"},
+	"am": {"ARM", "051", "Armenia"},
+	"ao": {"AGO", "024", "Angola"},
+	"aq": {"ATA", "010", "Antarctica"},
+	"ar": {"ARG", "032", "Argentina"},
+	"as": {"ASM", "016", "American Samoa"},
+	"at": {"AUT", "040", "Austria"},
+	"au": {"AUS", "036", "Australia"},
+	"aw": {"ABW", "533", "Aruba"},
+	"ax": {"ALA", "248", "Åland Islands"},
+	"az": {"AZE", "031", "Azerbaijan"},
+	"ba": {"BIH", "070", "Bosnia and Herzegovina"},
+	"bb": {"BRB", "052", "Barbados"},
+	"bd": {"BGD", "050", "Bangladesh"},
+	"be": {"BEL", "056", "Belgium"},
+	"bf": {"BFA", "854", "Burkina Faso"},
+	"bg": {"BGR", "100", "Bulgaria"},
+	"bh": {"BHR", "048", "Bahrain"},
+	"bi": {"BDI", "108", "Burundi"},
+	"bj": {"BEN", "204", "Benin"},
+	"bl": {"BLM", "652", "Saint Barthélemy"},
+	"bm": {"BMU", "060", "Bermuda"},
+	"bn": {"BRN", "096", "Brunei Darussalam"},
+	"bo": {"BOL", "068", "Bolivia"},
+	"bq": {"BES", "535", "Bonaire, Sint Eustatius and Saba"},
+	"br": {"BRA", "076", "Brazil"},
+	"bs": {"BHS", "044", "Bahamas"},
+	"bt": {"BTN", "064", "Bhutan"},
+	"bv": {"BVT", "074", "Bouvet Island"},
+	"bw": {"BWA", "072", "Botswana"},
+	"by": {"BLR", "112", "Belarus"},
+	"bz": {"BLZ", "084", "Belize"},
+	"ca": {"CAN", "124", "Canada"},
+	"cc": {"CCK", "166", "Cocos (Keeling) Islands"},
+	"cd": {"COD", "180", "Congo, The Democratic Republic of the"},
+	"cf": {"CAF", "140", "Central African Republic"},
+	"cg": {"COG", "178", "Congo"},
+	"ch": {"CHE", "756", "Switzerland"},
+	"ci": {"CIV", "384", "Côte d'Ivoire"},
+	"ck": {"COK", "184", "Cook Islands"},
+	"cl": {"CHL", "152", "Chile"},
+	"cm": {"CMR", "120", "Cameroon"},
+	"cn": {"CHN", "156", "China"},
+	"co": {"COL", "170", "Colombia"},
+	"cr": {"CRI", "188", "Costa Rica"},
+	"cu": {"CUB", "192", "Cuba"},
+	"cv": {"CPV", "132", "Cabo Verde"},
+	"cw": {"CUW", "531", "Curaçao"},
+	"cx": {"CXR", "162", "Christmas Island"},
+	"cy": {"CYP", "196", "Cyprus"},
+	"cz": {"CZE", "203", "Czechia"},
+	"de": {"DEU", "276", "Germany"},
+	"dj": {"DJI", "262", "Djibouti"},
+	"dk": {"DNK", "208", "Denmark"},
+	"dm": {"DMA", "212", "Dominica"},
+	"do": {"DOM", "214", "Dominican Republic"},
+	"dz": {"DZA", "012", "Algeria"},
+	"ec": {"ECU", "218", "Ecuador"},
+	"ee": {"EST", "233", "Estonia"},
+	"eg": {"EGY", "818", "Egypt"},
+	"eh": {"ESH", "732", "Western Sahara"},
+	"er": {"ERI", "232", "Eritrea"},
+	"es": {"ESP", "724", "Spain"},
+	"et": {"ETH", "231", "Ethiopia"},
+	"fi": {"FIN", "246", "Finland"},
+	"fj": {"FJI", "242", "Fiji"},
+	"fk": {"FLK", "238", "Falkland Islands (Malvinas)"},
+	"fm": {"FSM", "583", "Micronesia, Federated States of"},
+	"fo": {"FRO", "234", "Faroe Islands"},
+	"fr": {"FRA", "250", "France"},
+	"ga": {"GAB", "266", "Gabon"},
+	"gb": {"GBR", "826", "United Kingdom"},
+	"gd": {"GRD", "308", "Grenada"},
+	"ge": {"GEO", "268", "Georgia"},
+	"gf": {"GUF", "254", "French Guiana"},
+	"gg": {"GGY", "831", "Guernsey"},
+	"gh": {"GHA", "288", "Ghana"},
+	"gi": {"GIB", "292", "Gibraltar"},
+	"gl": {"GRL", "304", "Greenland"},
+	"gm": {"GMB", "270", "Gambia"},
+	"gn": {"GIN", "324", "Guinea"},
+	"gp": {"GLP", "312", "Guadeloupe"},
+	"gq": {"GNQ", "226", "Equatorial Guinea"},
+	"gr": {"GRC", "300", "Greece"},
+	"gs": {"SGS", "239", "South Georgia and the South Sandwich Islands"},
+	"gt": {"GTM", "320", "Guatemala"},
+	"gu": {"GUM", "316", "Guam"},
+	"gw": {"GNB", "624", "Guinea-Bissau"},
+	"gy": {"GUY", "328", "Guyana"},
+	"hk": {"HKG", "344", "Hong Kong"},
+	"hm": {"HMD", "334", "Heard Island and McDonald Islands"},
+	"hn": {"HND", "340", "Honduras"},
+	"hr": {"HRV", "191", "Croatia"},
+	"ht": {"HTI", "332", "Haiti"},
+	"hu": {"HUN", "348", "Hungary"},
+	"id": {"IDN", "360", "Indonesia"},
+	"ie": {"IRL", "372", "Ireland"},
+	"il": {"ISR", "376", "Israel"},
+	"im": {"IMN", "833", "Isle of Man"},
+	"in": {"IND", "356", "India"},
+	"io": {"IOT", "086", "British Indian Ocean Territory"},
+	"iq": {"IRQ", "368", "Iraq"},
+	"ir": {"IRN", "364", "Iran"},
+	"is": {"ISL", "352", "Iceland"},
+	"it": {"ITA", "380", "Italy"},
+	"je": {"JEY", "832", "Jersey"},
+	"jm": {"JAM", "388", "Jamaica"},
+	"jo": {"JOR", "400", "Jordan"},
+	"jp": {"JPN", "392", "Japan"},
+	"ke": {"KEN", "404", "Kenya"},
+	"kg": {"KGZ", "417", "Kyrgyzstan"},
+	"kh": {"KHM", "116", "Cambodia"},
+	"ki": {"KIR", "296", "Kiribati"},
+	"km": {"COM", "174", "Comoros"},
+	"kn": {"KNA", "659", "Saint Kitts and Nevis"},
+	"kp": {"PRK", "408", "North Korea"},
+	"kr": {"KOR", "410", "South Korea"},
+	"kw": {"KWT", "414", "Kuwait"},
+	"ky": {"CYM", "136", "Cayman Islands"},
+	"kz": {"KAZ", "398", "Kazakhstan"},
+	"la": {"LAO", "418", "Laos"},
+	"lb": {"LBN", "422", "Lebanon"},
+	"lc": {"LCA", "662", "Saint Lucia"},
+	"li": {"LIE", "438", "Liechtenstein"},
+	"lk": {"LKA", "144", "Sri Lanka"},
+	"lr": {"LBR", "430", "Liberia"},
+	"ls": {"LSO", "426", "Lesotho"},
+	"lt": {"LTU", "440", "Lithuania"},
+	"lu": {"LUX", "442", "Luxembourg"},
+	"lv": {"LVA", "428", "Latvia"},
+	"ly": {"LBY", "434", "Libya"},
+	"ma": {"MAR", "504", "Morocco"},
+	"mc": {"MCO", "492", "Monaco"},
+	"md": {"MDA", "498", "Moldova"},
+	"me": {"MNE", "499", "Montenegro"},
+	"mf": {"MAF", "663", "Saint Martin (French part)"},
+	"mg": {"MDG", "450", "Madagascar"},
+	"mh": {"MHL", "584", "Marshall Islands"},
+	"mk": {"MKD", "807", "North Macedonia"},
+	"ml": {"MLI", "466", "Mali"},
+	"mm": {"MMR", "104", "Myanmar"},
+	"mn": {"MNG", "496", "Mongolia"},
+	"mo": {"MAC", "446", "Macao"},
+	"mp": {"MNP", "580", "Northern Mariana Islands"},
+	"mq": {"MTQ", "474", "Martinique"},
+	"mr": {"MRT", "478", "Mauritania"},
+	"ms": {"MSR", "500", "Montserrat"},
+	"mt": {"MLT", "470", "Malta"},
+	"mu": {"MUS", "480", "Mauritius"},
+	"mv": {"MDV", "462", "Maldives"},
+	"mw": {"MWI", "454", "Malawi"},
+	"mx": {"MEX", "484", "Mexico"},
+	"my": {"MYS", "458", "Malaysia"},
+	"mz": {"MOZ", "508", "Mozambique"},
+	"na": {"NAM", "516", "Namibia"},
+	"nc": {"NCL", "540", "New Caledonia"},
+	"ne": {"NER", "562", "Niger"},
+	"nf": {"NFK", "574", "Norfolk Island"},
+	"ng": {"NGA", "566", "Nigeria"},
+	"ni": {"NIC", "558", "Nicaragua"},
+	"nl": {"NLD", "528", "Netherlands"},
+	"no": {"NOR", "578", "Norway"},
+	"np": {"NPL", "524", "Nepal"},
+	"nr": {"NRU", "520", "Nauru"},
+	"nu": {"NIU", "570", "Niue"},
+	"nz": {"NZL", "554", "New Zealand"},
+	"om": {"OMN", "512", "Oman"},
+	"pa": {"PAN", "591", "Panama"},
+	"pe": {"PER", "604", "Peru"},
+	"pf": {"PYF", "258", "French Polynesia"},
+	"pg": {"PNG", "598", "Papua New Guinea"},
+	"ph": {"PHL", "608", "Philippines"},
+	"pk": {"PAK", "586", "Pakistan"},
+	"pl": {"POL", "616", "Poland"},
+	"pm": {"SPM", "666", "Saint Pierre and Miquelon"},
+	"pn": {"PCN", "612", "Pitcairn"},
+	"pr": {"PRI", "630", "Puerto Rico"},
+	"ps": {"PSE", "275", "Palestine, State of"},
+	"pt": {"PRT", "620", "Portugal"},
+	"pw": {"PLW", "585", "Palau"},
+	"py": {"PRY", "600", "Paraguay"},
+	"qa": {"QAT", "634", "Qatar"},
+	"re": {"REU", "638", "Réunion"},
+	"ro": {"ROU", "642", "Romania"},
+	"rs": {"SRB", "688", "Serbia"},
+	"ru": {"RUS", "643", "Russian Federation"},
+	"rw": {"RWA", "646", "Rwanda"},
+	"sa": {"SAU", "682", "Saudi Arabia"},
+	"sb": {"SLB", "090", "Solomon Islands"},
+	"sc": {"SYC", "690", "Seychelles"},
+	"sd": {"SDN", "729", "Sudan"},
+	"se": {"SWE", "752", "Sweden"},
+	"sg": {"SGP", "702", "Singapore"},
+	"sh": {"SHN", "654", "Saint Helena, Ascension and Tristan da Cunha"},
+	"si": {"SVN", "705", "Slovenia"},
+	"sj": {"SJM", "744", "Svalbard and Jan Mayen"},
+	"sk": {"SVK", "703", "Slovakia"},
+	"sl": {"SLE", "694", "Sierra Leone"},
+	"sm": {"SMR", "674", "San Marino"},
+	"sn": {"SEN", "686", "Senegal"},
+	"so": {"SOM", "706", "Somalia"},
+	"sr": {"SUR", "740", "Suriname"},
+	"ss": {"SSD", "728", "South Sudan"},
+	"st": {"STP", "678", "Sao Tome and Principe"},
+	"sv": {"SLV", "222", "El Salvador"},
+	"sx": {"SXM", "534", "Sint Maarten (Dutch part)"},
+	"sy": {"SYR", "760", "Syria"},
+	"sz": {"SWZ", "748", "Eswatini"},
+	"tc": {"TCA", "796", "Turks and Caicos Islands"},
+	"td": {"TCD", "148", "Chad"},
+	"tf": {"ATF", "260", "French Southern Territories"},
+	"tg": {"TGO", "768", "Togo"},
+	"th": {"THA", "764", "Thailand"},
+	"tj": {"TJK", "762", "Tajikistan"},
+	"tk": {"TKL", "772", "Tokelau"},
+	"tl": {"TLS", "626", "Timor-Leste"},
+	"tm": {"TKM", "795", "Turkmenistan"},
+	"tn": {"TUN", "788", "Tunisia"},
+	"to": {"TON", "776", "Tonga"},
+	"tr": {"TUR", "792", "Türkiye"},
+	"tt": {"TTO", "780", "Trinidad and Tobago"},
+	"tv": {"TUV", "798", "Tuvalu"},
+	"tw": {"TWN", "158", "Taiwan"},
+	"tz": {"TZA", "834", "Tanzania"},
+	"ua": {"UKR", "804", "Ukraine"},
+	"ug": {"UGA", "800", "Uganda"},
+	"um": {"UMI", "581", "United States Minor Outlying Islands"},
+	"us": {"USA", "840", "United States"},
+	"uy": {"URY", "858", "Uruguay"},
+	"uz": {"UZB", "860", "Uzbekistan"},
+	"va": {"VAT", "336", "Holy See (Vatican City State)"},
+	"vc": {"VCT", "670", "Saint Vincent and the Grenadines"},
+	"ve": {"VEN", "862", "Venezuela"},
+	"vg": {"VGB", "092", "Virgin Islands, British"},
+	"vi": {"VIR", "850", "Virgin Islands, U.S."},
+	"vn": {"VNM", "704", "Vietnam"},
+	"vu": {"VUT", "548", "Vanuatu"},
+	"wf": {"WLF", "876", "Wallis and Futuna"},
+	"ws": {"WSM", "882", "Samoa"},
+	"ye": {"YEM", "887", "Yemen"},
+	"yt": {"MYT", "175", "Mayotte"},
+	"za": {"ZAF", "710", "South Africa"},
+	"zm": {"ZMB", "894", "Zambia"},
+	"zw": {"ZWE", "716", "Zimbabwe"},
+}
+
+// CountryName returns the English name of the country with the ISO 3166-1 alpha-2 code (example: "Taiwan" for TW).
+//
+// Case insensitive. It can be used with the Region of a language.
+// If the code is unknown, it will return empty string.
+func CountryName(region string) string {
+	return countries[toLowerASCII(region)].name
+}
+
+// CountryAlpha3 returns the ISO 3166-1 alpha-3 code of the country with the alpha-2 code (example: "USA" for US).
+//
+// Case insensitive. If the code is unknown, it will return empty string.
+func CountryAlpha3(region string) string {
+	return countries[toLowerASCII(region)].alpha3
+}
+
+// CountryM49 returns the ISO 3166-1 numeric code of the country with the alpha-2 code,
+// which is the same as its UN M49 code (example: "840" for US).
+//
+// Case insensitive. If the code is unknown, it will return empty string.
+func CountryM49(region string) string {
+	return countries[toLowerASCII(region)].numeric
+}
+
+// CountryAlpha2 returns the ISO 3166-1 alpha-2 code of the country, in upper case,
+// from its alpha-2, alpha-3 or numeric code (example: "US" for USA or 840).
+//
+// Case insensitive. If the code is unknown, it will return empty string.
+func CountryAlpha2(code string) string {
+	code = toLowerASCII(code)
+	if _, ok := countries[code]; ok {
+		return strings.ToUpper(code)
+	}
+	for alpha2, c := range countries {
+		if toLowerASCII(c.alpha3) == code || c.numeric == code {
+			return strings.ToUpper(alpha2)
+		}
+	}
+	return ""
+}
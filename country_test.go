@@ -0,0 +1,43 @@
+package slang_test
+
+import (
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestCountry(t *testing.T) {
+	tests := map[string][3]string{
+		"US": {"United States", "USA", "840"},
+		"tw": {"Taiwan", "TWN", "158"},
+		"GB": {"United Kingdom", "GBR", "826"},
+		"AX": {"Åland Islands", "ALA", "248"},
+		"XX": {"", "", ""},
+		"":   {"", "", ""},
+	}
+	for region, expected := range tests {
+		if name := slang.CountryName(region); name != expected[0] {
+			t.Errorf("Error: CountryName(%s) should be '%s', got '%s'", region, expected[0], name)
+		}
+		if alpha3 := slang.CountryAlpha3(region); alpha3 != expected[1] {
+			t.Errorf("Error: CountryAlpha3(%s) should be '%s', got '%s'", region, expected[1], alpha3)
+		}
+		if m49 := slang.CountryM49(region); m49 != expected[2] {
+			t.Errorf("Error: CountryM49(%s) should be '%s', got '%s'", region, expected[2], m49)
+		}
+	}
+
+	for code, expected := range map[string]string{"us": "US", "USA": "US", "twn": "TW", "158": "TW", "419": "", "XXX": ""} {
+		if alpha2 := slang.CountryAlpha2(code); alpha2 != expected {
+			t.Errorf("Error: CountryAlpha2(%s) should be '%s', got '%s'", code, expected, alpha2)
+		}
+	}
+
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	if lang := lp.FindByBCP47("zh-TW"); lang == nil || slang.CountryName(lang.Region()) != "Taiwan" {
+		t.Errorf("Error: CountryName(Region()) of zh-TW should be 'Taiwan'")
+	}
+}
@@ -0,0 +1,28 @@
+package slang_test
+
+import (
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestCurrencyForRegion(t *testing.T) {
+	for region, expected := range map[string]string{
+		"JP":  "JPY",
+		"us":  "USD",
+		"GB":  "GBP",
+		"GBR": "GBP",
+		"276": "EUR",
+		"HR":  "EUR",
+		"CN":  "CNY",
+		"CW":  "XCG",
+		"SX":  "XCG",
+		"AQ":  "",
+		"ZZ":  "",
+		"":    "",
+	} {
+		if currency := slang.CurrencyForRegion(region); currency != expected {
+			t.Errorf("Error: CurrencyForRegion(%s) should be '%s', got '%s'", region, expected, currency)
+		}
+	}
+}
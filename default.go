@@ -0,0 +1,158 @@
+package slang
+
+import "sync"
+
+var (
+	defaultOnce   sync.Once
+	defaultParser *LangParser
+	defaultErr    error
+)
+
+// defaultLangParser returns the package-level default parser, initializing it on first use.
+//
+// If the initialization failed, it will return an empty parser, so all lookups return no result.
+func defaultLangParser() *LangParser {
+	defaultOnce.Do(func() {
+		defaultParser, defaultErr = NewParser()
+		if defaultErr != nil {
+			defaultParser = &LangParser{}
+		}
+	})
+	return defaultParser
+}
+
+// DefaultErr returns the error occurred when initializing the package-level default parser, or nil if there is none.
+//
+// The default parser is used by package-level functions such as Parse and FindByBCP47.
+// It is initialized lazily on first use, so calling DefaultErr will also initialize it.
+func DefaultErr() error {
+	defaultLangParser()
+	return defaultErr
+}
+
+// Default returns the package-level default parser, which is shared by all callers and by package-level functions
+// such as Parse and FindByBCP47. The embedded database is loaded once, so it avoids a copy of the languages
+// for each component calling NewParser.
+//
+// The default parser is global: languages added by AddCustom or options set by the With* methods affect all its users.
+// Call Clone to customize it, or pass it as a Reader to code which should only query it.
+//
+// The error is the same as DefaultErr.
+func Default() (*LangParser, error) {
+	return defaultLangParser(), defaultErr
+}
+
+// Parse tries to parse the language code using the default parser. See LangParser.Parse for details.
+func Parse(value string) *Lang {
+	return defaultLangParser().Parse(value)
+}
+
+// ParseE is like Parse, but returns an error instead of nil when the language cannot be parsed. See LangParser.ParseE for details.
+func ParseE(value string) (*Lang, error) {
+	return defaultLangParser().ParseE(value)
+}
+
+// FindAllByBCP47 returns all possible values matching the BCP47 tag using the default parser. See LangParser.FindAllByBCP47 for details.
+func FindAllByBCP47(bcp47 string) []Lang {
+	return defaultLangParser().FindAllByBCP47(bcp47)
+}
+
+// FindAllByWinID returns all possible values matching the Windows language ID using the default parser. See LangParser.FindAllByWinID for details.
+func FindAllByWinID(winID string) []Lang {
+	return defaultLangParser().FindAllByWinID(winID)
+}
+
+// FindAllByISO639Set1 returns all possible values matching the ISO 639-1 code using the default parser. See LangParser.FindAllByISO639Set1 for details.
+func FindAllByISO639Set1(iso639 string) []Lang {
+	return defaultLangParser().FindAllByISO639Set1(iso639)
+}
+
+// FindAllByISO639Set2 returns all possible values matching the ISO 639-2 code using the default parser. See LangParser.FindAllByISO639Set2 for details.
+func FindAllByISO639Set2(iso639 string) []Lang {
+	return defaultLangParser().FindAllByISO639Set2(iso639)
+}
+
+// FindAllByISO639Set3 returns all possible values matching the ISO 639-3 code using the default parser. See LangParser.FindAllByISO639Set3 for details.
+func FindAllByISO639Set3(iso639 string) []Lang {
+	return defaultLangParser().FindAllByISO639Set3(iso639)
+}
+
+// FindAllByISOCode returns all possible values matching the given ISO 639 code using the default parser. See LangParser.FindAllByISOCode for details.
+func FindAllByISOCode(iso639 string) []Lang {
+	return defaultLangParser().FindAllByISOCode(iso639)
+}
+
+// FindByBCP47 returns the first possible best value matching the BCP47 tag using the default parser. See LangParser.FindByBCP47 for details.
+func FindByBCP47(bcp47 string) *Lang {
+	return defaultLangParser().FindByBCP47(bcp47)
+}
+
+// FindByWinID returns the first possible best value matching the Windows language ID using the default parser. See LangParser.FindByWinID for details.
+func FindByWinID(winID string) *Lang {
+	return defaultLangParser().FindByWinID(winID)
+}
+
+// FindByISO639Set1 returns the first possible best value matching the ISO 639-1 code using the default parser. See LangParser.FindByISO639Set1 for details.
+func FindByISO639Set1(iso639 string) *Lang {
+	return defaultLangParser().FindByISO639Set1(iso639)
+}
+
+// FindByISO639Set2 returns the first possible best value matching the ISO 639-2 code using the default parser. See LangParser.FindByISO639Set2 for details.
+func FindByISO639Set2(iso639 string) *Lang {
+	return defaultLangParser().FindByISO639Set2(iso639)
+}
+
+// FindByISO639Set3 returns the first possible best value matching the ISO 639-3 code using the default parser. See LangParser.FindByISO639Set3 for details.
+func FindByISO639Set3(iso639 string) *Lang {
+	return defaultLangParser().FindByISO639Set3(iso639)
+}
+
+// FindByISOCode returns the first possible best value matching the given ISO 639 code using the default parser. See LangParser.FindByISOCode for details.
+func FindByISOCode(iso639 string) *Lang {
+	return defaultLangParser().FindByISOCode(iso639)
+}
+
+// FindByBCP47E is like FindByBCP47, but returns ErrNotFound instead of nil when no value is found. It uses the default parser.
+func FindByBCP47E(bcp47 string) (*Lang, error) {
+	return defaultLangParser().FindByBCP47E(bcp47)
+}
+
+// FindByWinIDE is like FindByWinID, but returns ErrNotFound instead of nil when no value is found. It uses the default parser.
+func FindByWinIDE(winID string) (*Lang, error) {
+	return defaultLangParser().FindByWinIDE(winID)
+}
+
+// FindByISO639Set1E is like FindByISO639Set1, but returns ErrNotFound instead of nil when no value is found. It uses the default parser.
+func FindByISO639Set1E(iso639 string) (*Lang, error) {
+	return defaultLangParser().FindByISO639Set1E(iso639)
+}
+
+// FindByISO639Set2E is like FindByISO639Set2, but returns ErrNotFound instead of nil when no value is found. It uses the default parser.
+func FindByISO639Set2E(iso639 string) (*Lang, error) {
+	return defaultLangParser().FindByISO639Set2E(iso639)
+}
+
+// FindByISO639Set3E is like FindByISO639Set3, but returns ErrNotFound instead of nil when no value is found. It uses the default parser.
+func FindByISO639Set3E(iso639 string) (*Lang, error) {
+	return defaultLangParser().FindByISO639Set3E(iso639)
+}
+
+// FindByISOCodeE is like FindByISOCode, but returns ErrNotFound instead of nil when no value is found. It uses the default parser.
+func FindByISOCodeE(iso639 string) (*Lang, error) {
+	return defaultLangParser().FindByISOCodeE(iso639)
+}
+
+// NewSupported creates a Supported for the given BCP47 tags using the default parser. See LangParser.NewSupported for details.
+func NewSupported(tags ...string) *Supported {
+	return defaultLangParser().NewSupported(tags...)
+}
+
+// MatchConfidence scores how close the tag have is to the tag want using the default parser. See LangParser.MatchConfidence for details.
+func MatchConfidence(want, have string) int {
+	return defaultLangParser().MatchConfidence(want, have)
+}
+
+// ScriptToLikelyLanguages returns the languages normally written in the script using the default parser. See LangParser.ScriptToLikelyLanguages for details.
+func ScriptToLikelyLanguages(script string) []Lang {
+	return defaultLangParser().ScriptToLikelyLanguages(script)
+}
@@ -0,0 +1,54 @@
+package slang_test
+
+import (
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestDefaultParser(t *testing.T) {
+	if err := slang.DefaultErr(); err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	if lang := slang.Parse("en-US"); lang == nil || lang.BCP47 != "en-US" {
+		t.Errorf("Error: Parse(en-US) should be 'en-US'")
+	}
+	if lang := slang.FindByBCP47("zh_tw"); lang == nil || lang.BCP47 != "zh-TW" {
+		t.Errorf("Error: FindByBCP47(zh_tw) should be 'zh-TW'")
+	}
+	if lang := slang.FindByWinID("CHS"); lang == nil || lang.BCP47 != "zh" {
+		t.Errorf("Error: FindByWinID(CHS) should be 'zh'")
+	}
+	if lang := slang.FindByISOCode("wuu"); lang == nil || lang.BCP47 != "zh" {
+		t.Errorf("Error: FindByISOCode(wuu) should be 'zh'")
+	}
+	if langs := slang.FindAllByBCP47("bho-Deva"); len(langs) != 3 {
+		t.Errorf("Error: FindAllByBCP47(bho-Deva) should have 3 languages")
+	}
+	if lang := slang.Parse("invalid"); lang != nil {
+		t.Errorf("Error: Parse(invalid) should be nil")
+	}
+}
+
+func TestDefault(t *testing.T) {
+	lp, err := slang.Default()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	again, err := slang.Default()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	if lp != again {
+		t.Errorf("Error: Default() should return the same parser")
+	}
+	if lang := lp.Parse("en-US"); lang == nil || lang.BCP47 != "en-US" {
+		t.Errorf("Error: Default().Parse(en-US) should be 'en-US'")
+	}
+
+	clone := lp.Clone().AddCustom(slang.Lang{Name: "Klingon", BCP47: "kg-SU"})
+	if clone.Parse("kg-SU") == nil || slang.Parse("kg-SU") != nil {
+		t.Errorf("Error: AddCustom on a clone of Default() should not affect the default parser")
+	}
+}
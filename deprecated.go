@@ -0,0 +1,91 @@
+package slang
+
+// deprecatedISOCodes maps deprecated or withdrawn ISO 639 codes to the current ones, in lower case.
+var deprecatedISOCodes = map[string]string{
+	// ISO 639-1
+	"in": "id", // Indonesian, changed in 1989
+	"iw": "he", // Hebrew, changed in 1989
+	"ji": "yi", // Yiddish, changed in 1989
+	"jw": "jv", // Javanese, changed in 2001
+	"mo": "ro", // Moldavian, merged into Romanian in 2008
+
+	// ISO 639-2
+	"mol": "ron", // Moldavian, merged into Romanian in 2008
+	"scc": "srp", // Serbian, bibliographic code withdrawn in 2008
+	"scr": "hrv", // Croatian, bibliographic code withdrawn in 2008
+}
+
+// retirement is a retired ISO 639 code, with its replacement if there is a single one.
+type retirement struct {
+	replacement string
+	reason      string
+}
+
+// retiredISOCodes is a subset of the ISO 639-3 code retirements, keyed by the retired code in lower case.
+//
+// See: https://iso639-3.sil.org/code_tables/deprecated_codes/data
+var retiredISOCodes = map[string]retirement{
+	"aue": {"ktz", "merged into ktz"},
+	"ayx": {"nun", "duplicate of nun"},
+	"bh":  {"", "split into bho, mai and mag"},
+	"bjd": {"drl", "merged into drl"},
+	"cmk": {"xch", "duplicate of xch"},
+	"drh": {"khk", "merged into khk"},
+	"drw": {"prs", "merged into prs"},
+	"ggr": {"", "split into gtu and ikr"},
+	"gli": {"kzk", "merged into kzk"},
+	"kgh": {"kml", "merged into kml"},
+	"mol": {"ron", "merged into ron"},
+	"mst": {"mry", "merged into mry"},
+	"myt": {"mry", "merged into mry"},
+	"nbf": {"", "split into nru and nxq"},
+	"prb": {"", "non-existent"},
+	"sca": {"hle", "merged into hle"},
+	"sgo": {"", "non-existent"},
+	"tlw": {"weo", "merged into weo"},
+	"tmp": {"tyj", "merged into tyj"},
+	"tnf": {"prs", "merged into prs"},
+	"xst": {"", "split into stv and wle"},
+	"ymt": {"mtm", "merged into mtm"},
+	"yos": {"zom", "merged into zom"},
+}
+
+// Retirements returns why the ISO 639 code was retired, and the code to use instead.
+//
+// Case insensitive. The replacement is empty if the code was split into several languages
+// or was retired as non-existent, in which case the reason lists the codes to choose from, if any.
+//
+// If the code is not retired, ok will be false.
+//
+// # Examples
+//  1. "drh" will return "khk", "merged into khk", true.
+//  2. "bh" will return "", "split into bho, mai and mag", true.
+//  3. "en" will return "", "", false.
+func Retirements(code string) (replacement string, reason string, ok bool) {
+	retired, ok := retiredISOCodes[toLowerASCII(code)]
+	return retired.replacement, retired.reason, ok
+}
+
+// NormalizeISOCode returns the current ISO 639 code for a deprecated one, or the code itself if it is not deprecated.
+//
+// Case insensitive. The result of a deprecated code is in lower case.
+//
+// The mapping table is:
+//
+//	in  -> id     Indonesian
+//	iw  -> he     Hebrew
+//	ji  -> yi     Yiddish
+//	jw  -> jv     Javanese
+//	mo  -> ro     Moldavian, merged into Romanian
+//	mol -> ron    Moldavian, merged into Romanian
+//	scc -> srp    Serbian
+//	scr -> hrv    Croatian
+//
+// FindAllByISOCode and FindByISOCode normalize the code before the lookup.
+// Use FindAllByISO639Set1, FindAllByISO639Set2 or FindAllByISO639Set3 to look up the exact code.
+func NormalizeISOCode(code string) string {
+	if current, ok := deprecatedISOCodes[toLowerASCII(code)]; ok {
+		return current
+	}
+	return code
+}
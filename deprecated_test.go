@@ -0,0 +1,79 @@
+package slang_test
+
+import (
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestNormalizeISOCode(t *testing.T) {
+	tests := map[string]string{
+		"iw":  "he",
+		"IN":  "id",
+		"ji":  "yi",
+		"jw":  "jv",
+		"mo":  "ro",
+		"mol": "ron",
+		"scc": "srp",
+		"he":  "he",
+		"EN":  "EN",
+		"":    "",
+	}
+	for code, expected := range tests {
+		if actual := slang.NormalizeISOCode(code); actual != expected {
+			t.Errorf("Error: NormalizeISOCode(%s) should be '%s', got '%s'", code, expected, actual)
+		}
+	}
+}
+
+func TestFindByISOCodeDeprecated(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	for code, expected := range map[string]string{"iw": "he", "in": "id", "mo": "ro", "scr": "hr"} {
+		if lang := lp.FindByISOCode(code); lang == nil || lang.BCP47 != expected {
+			t.Errorf("Error: FindByISOCode(%s) should be '%s', got %v", code, expected, lang)
+		}
+	}
+	if langs := lp.FindAllByISO639Set1("iw"); len(langs) != 0 {
+		t.Errorf("Error: FindAllByISO639Set1(iw) should be empty")
+	}
+	if lang := lp.Parse("iw"); lang == nil || lang.BCP47 != "he" {
+		t.Errorf("Error: Parse(iw) should be 'he'")
+	}
+}
+
+func TestRetirements(t *testing.T) {
+	if replacement, reason, ok := slang.Retirements("DRH"); !ok || replacement != "khk" || reason != "merged into khk" {
+		t.Errorf("Error: Retirements(DRH) should be 'khk', got '%s', '%s', %v", replacement, reason, ok)
+	}
+	if replacement, reason, ok := slang.Retirements("bh"); !ok || replacement != "" || reason == "" {
+		t.Errorf("Error: Retirements(bh) should have no replacement, got '%s', '%s', %v", replacement, reason, ok)
+	}
+	if _, _, ok := slang.Retirements("en"); ok {
+		t.Errorf("Error: Retirements(en) should not be retired")
+	}
+}
+
+func TestWithRetirements(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	if lang := lp.FindByISOCode("drh"); lang != nil {
+		t.Errorf("Error: FindByISOCode(drh) should be nil by default")
+	}
+	lp.WithRetirements()
+	if lang := lp.FindByISOCode("drh"); lang == nil || lang.ISO639Set3 != "khk" {
+		t.Errorf("Error: FindByISOCode(drh) should be 'khk', got %v", lang)
+	}
+	if lang, kind := lp.ParseWithMatch("drh"); lang == nil || lang.ISO639Set3 != "khk" || kind != slang.MatchISO3 {
+		t.Errorf("Error: ParseWithMatch(drh) should be 'khk' by ISO639-3, got %v by %v", lang, kind)
+	}
+	if lang := lp.FindByISOCode("bh"); lang != nil {
+		t.Errorf("Error: FindByISOCode(bh) should be nil, as it has no single replacement")
+	}
+}
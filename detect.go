@@ -0,0 +1,148 @@
+package slang
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// maxDetectLetters is the number of letters sampled by DetectScript.
+const maxDetectLetters = 1024
+
+// unicodeScriptCodes maps lower-cased Unicode script names, as in unicode.Scripts,
+// to the ISO 15924 codes used by likely subtags of the languages written in the script.
+var unicodeScriptCodes = map[string][]string{
+	"arabic":              {"Arab"},
+	"armenian":            {"Armn"},
+	"bengali":             {"Beng"},
+	"canadian_aboriginal": {"Cans"},
+	"cherokee":            {"Cher"},
+	"cyrillic":            {"Cyrl"},
+	"devanagari":          {"Deva"},
+	"ethiopic":            {"Ethi"},
+	"georgian":            {"Geor"},
+	"greek":               {"Grek"},
+	"gujarati":            {"Gujr"},
+	"gurmukhi":            {"Guru"},
+	"han":                 {"Hans", "Hant", "Jpan"},
+	"hangul":              {"Kore"},
+	"hebrew":              {"Hebr"},
+	"hiragana":            {"Jpan"},
+	"kannada":             {"Knda"},
+	"katakana":            {"Jpan"},
+	"khmer":               {"Khmr"},
+	"lao":                 {"Laoo"},
+	"latin":               {"Latn"},
+	"malayalam":           {"Mlym"},
+	"mongolian":           {"Mong"},
+	"myanmar":             {"Mymr"},
+	"oriya":               {"Orya"},
+	"sinhala":             {"Sinh"},
+	"syriac":              {"Syrc"},
+	"tamil":               {"Taml"},
+	"telugu":              {"Telu"},
+	"thaana":              {"Thaa"},
+	"thai":                {"Thai"},
+	"tibetan":             {"Tibt"},
+	"tifinagh":            {"Tfng"},
+	"vai":                 {"Vaii"},
+	"yi":                  {"Yiii"},
+}
+
+// DetectScript returns the Unicode script name, as in unicode.Scripts, of most letters in the text
+// (example: "Latin", "Han", "Cyrillic", "Arabic" or "Devanagari").
+//
+// It is a heuristic: only the first 1024 letters are sampled, and digits, punctuation, symbols
+// and marks are ignored. Text mixing scripts returns the one with the most letters, so Japanese text may be
+// detected as "Han", "Hiragana" or "Katakana". If multiple scripts have the same number of letters,
+// the first one by name is returned.
+//
+// If the text has no letter, it will return empty string.
+func DetectScript(text string) string {
+	counts := map[string]int{}
+	letters := 0
+	for _, r := range text {
+		if letters == maxDetectLetters {
+			break
+		}
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+		if script := scriptOfRune(r); script != "" {
+			counts[script]++
+		}
+	}
+
+	best := ""
+	for script, count := range counts {
+		if count > counts[best] || (count == counts[best] && script < best) {
+			best = script
+		}
+	}
+	return best
+}
+
+// detectScripts is the names of unicode.Scripts in the order tried by DetectScript,
+// starting from the scripts having languages, as they are the most common.
+var detectScripts = func() []string {
+	names := make([]string, 0, len(unicode.Scripts))
+	for name := range unicode.Scripts {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		_, a := unicodeScriptCodes[toLowerASCII(names[i])]
+		_, b := unicodeScriptCodes[toLowerASCII(names[j])]
+		if a != b {
+			return a
+		}
+		return names[i] < names[j]
+	})
+	return names
+}()
+
+// scriptOfRune returns the Unicode script name of the rune.
+func scriptOfRune(r rune) string {
+	for _, name := range detectScripts {
+		if unicode.Is(unicode.Scripts[name], r) {
+			return name
+		}
+	}
+	return ""
+}
+
+// ScriptToLikelyLanguages returns the languages normally written in the script, as candidates for text
+// in the script detected by DetectScript (example: "Cyrillic" will return ru, uk, bg and more).
+//
+// The script can be a Unicode script name as returned by DetectScript, or an ISO 15924 code (example: "Cyrl").
+// Case insensitive. Languages are the ones whose likely script is the script (see AddLikelySubtags),
+// so it is a hint from a minimal table rather than a complete list. Result is sorted by BCP47 tag length.
+//
+// If the script is unknown, it will return an empty slice.
+func (p *LangParser) ScriptToLikelyLanguages(script string) []Lang {
+	codes, ok := unicodeScriptCodes[toLowerASCII(strings.ReplaceAll(script, " ", "_"))]
+	if !ok {
+		codes = []string{ScriptOf("und-" + script)}
+	}
+
+	results := []Lang{}
+	for language, likely := range likelySubtags {
+		if strings.Contains(language, "-") || !containsFoldASCII(codes, ScriptOf(likely)) {
+			continue
+		}
+		if lang := p.FindByBCP47(language); lang != nil && equalFoldASCII(lang.BCP47, language) {
+			results = append(results, *lang)
+		}
+	}
+	sortByBCP47Tag(results)
+	return uniqueLangs(results)
+}
+
+func containsFoldASCII(values []string, value string) bool {
+	for _, v := range values {
+		if value != "" && equalFoldASCII(v, value) {
+			return true
+		}
+	}
+	return false
+}
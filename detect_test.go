@@ -0,0 +1,52 @@
+package slang_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestDetectScript(t *testing.T) {
+	for text, expected := range map[string]string{
+		"Hello, world!":           "Latin",
+		"Привет, мир":             "Cyrillic",
+		"你好，世界":                   "Han",
+		"مرحبا بالعالم":           "Arabic",
+		"नमस्ते दुनिया":           "Devanagari",
+		"안녕하세요":                   "Hangul",
+		"Γειά σου Κόσμε":          "Greek",
+		"Hello Мир Мир":           "Cyrillic",
+		"12345 !?":                "",
+		"":                        "",
+		strings.Repeat("a", 2000): "Latin",
+	} {
+		if script := slang.DetectScript(text); script != expected {
+			t.Errorf("Error: DetectScript(%q) should be '%s', got '%s'", text, expected, script)
+		}
+	}
+}
+
+func TestScriptToLikelyLanguages(t *testing.T) {
+	for script, expected := range map[string][]string{
+		"Cyrillic": {"ru", "uk", "bg"},
+		"cyrl":     {"ru", "uk"},
+		"Han":      {"zh", "ja"},
+		"Hangul":   {"ko"},
+		"Arabic":   {"ar", "fa"},
+		"Latin":    {"en", "fr", "de"},
+	} {
+		langs := slang.ScriptToLikelyLanguages(script)
+		for _, bcp47 := range expected {
+			if !hasBCP47(langs, bcp47) {
+				t.Errorf("Error: ScriptToLikelyLanguages(%s) should contain '%s', got %v", script, bcp47, langs)
+			}
+		}
+	}
+	if langs := slang.ScriptToLikelyLanguages("Latin"); hasBCP47(langs, "ru") {
+		t.Errorf("Error: ScriptToLikelyLanguages(Latin) should not contain 'ru'")
+	}
+	if langs := slang.ScriptToLikelyLanguages("Klingon"); len(langs) != 0 {
+		t.Errorf("Error: ScriptToLikelyLanguages(Klingon) should be empty, got %v", langs)
+	}
+}
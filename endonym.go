@@ -0,0 +1,146 @@
+package slang
+
+// endonyms maps ISO 639-1 codes, or ISO 639-3 codes for languages without one, to the native names of the languages.
+var endonyms = map[string]string{
+	"af":  "Afrikaans",
+	"am":  "አማርኛ",
+	"ar":  "العربية",
+	"as":  "অসমীয়া",
+	"az":  "Azərbaycan",
+	"ba":  "Башҡорт",
+	"be":  "Беларуская",
+	"bg":  "Български",
+	"bn":  "বাংলা",
+	"bo":  "བོད་སྐད་",
+	"br":  "Brezhoneg",
+	"bs":  "Bosanski",
+	"ca":  "Català",
+	"ceb": "Cebuano",
+	"chr": "ᏣᎳᎩ",
+	"co":  "Corsu",
+	"cs":  "Čeština",
+	"cy":  "Cymraeg",
+	"da":  "Dansk",
+	"de":  "Deutsch",
+	"dv":  "ދިވެހިބަސް",
+	"el":  "Ελληνικά",
+	"en":  "English",
+	"eo":  "Esperanto",
+	"es":  "Español",
+	"et":  "Eesti",
+	"eu":  "Euskara",
+	"fa":  "فارسی",
+	"ff":  "Pulaar",
+	"fi":  "Suomi",
+	"fil": "Filipino",
+	"fo":  "Føroyskt",
+	"fr":  "Français",
+	"fy":  "Frysk",
+	"ga":  "Gaeilge",
+	"gd":  "Gàidhlig",
+	"gl":  "Galego",
+	"gn":  "Avañe'ẽ",
+	"gu":  "ગુજરાતી",
+	"ha":  "Hausa",
+	"haw": "ʻŌlelo Hawaiʻi",
+	"he":  "עברית",
+	"hi":  "हिन्दी",
+	"hr":  "Hrvatski",
+	"hu":  "Magyar",
+	"hy":  "Հայերեն",
+	"id":  "Bahasa Indonesia",
+	"ig":  "Igbo",
+	"is":  "Íslenska",
+	"it":  "Italiano",
+	"iu":  "ᐃᓄᒃᑎᑐᑦ",
+	"ja":  "日本語",
+	"jv":  "Basa Jawa",
+	"ka":  "ქართული",
+	"kk":  "Қазақ тілі",
+	"kl":  "Kalaallisut",
+	"km":  "ខ្មែរ",
+	"kn":  "ಕನ್ನಡ",
+	"ko":  "한국어",
+	"kok": "कोंकणी",
+	"ks":  "کٲشُر",
+	"ku":  "Kurdî",
+	"ky":  "Кыргызча",
+	"la":  "Latina",
+	"lb":  "Lëtzebuergesch",
+	"lo":  "ລາວ",
+	"lt":  "Lietuvių",
+	"lv":  "Latviešu",
+	"mg":  "Malagasy",
+	"mi":  "Te Reo Māori",
+	"mk":  "Македонски",
+	"ml":  "മലയാളം",
+	"mn":  "Монгол",
+	"mr":  "मराठी",
+	"ms":  "Bahasa Melayu",
+	"mt":  "Malti",
+	"my":  "မြန်မာ",
+	"nb":  "Norsk bokmål",
+	"ne":  "नेपाली",
+	"nl":  "Nederlands",
+	"nn":  "Norsk nynorsk",
+	"no":  "Norsk",
+	"oc":  "Occitan",
+	"om":  "Oromoo",
+	"or":  "ଓଡ଼ିଆ",
+	"pa":  "ਪੰਜਾਬੀ",
+	"pl":  "Polski",
+	"ps":  "پښتو",
+	"pt":  "Português",
+	"qu":  "Runasimi",
+	"rm":  "Rumantsch",
+	"ro":  "Română",
+	"ru":  "Русский",
+	"rw":  "Kinyarwanda",
+	"sa":  "संस्कृतम्",
+	"sd":  "سنڌي",
+	"se":  "Davvisámegiella",
+	"si":  "සිංහල",
+	"sk":  "Slovenčina",
+	"sl":  "Slovenščina",
+	"so":  "Soomaali",
+	"sq":  "Shqip",
+	"sr":  "Српски",
+	"sv":  "Svenska",
+	"sw":  "Kiswahili",
+	"ta":  "தமிழ்",
+	"te":  "తెలుగు",
+	"tg":  "Тоҷикӣ",
+	"th":  "ไทย",
+	"ti":  "ትግርኛ",
+	"tk":  "Türkmen dili",
+	"tr":  "Türkçe",
+	"tt":  "Татар",
+	"ug":  "ئۇيغۇرچە",
+	"uk":  "Українська",
+	"ur":  "اردو",
+	"uz":  "Oʻzbekcha",
+	"vi":  "Tiếng Việt",
+	"wo":  "Wolof",
+	"xh":  "isiXhosa",
+	"yi":  "ייִדיש",
+	"yo":  "Èdè Yorùbá",
+	"yue": "粵語",
+	"zh":  "中文",
+	"zu":  "isiZulu",
+}
+
+// Endonym returns the native name of the language (example: Français for French, 中文 for Chinese).
+//
+// The native name is looked up by ISO 639-3 code first, then ISO 639-1 code,
+// from an embedded table covering the most used languages. It does not depend on the script or region of the language.
+//
+// If the native name is unknown, it will return the English Name of the language.
+func (lang Lang) Endonym() string {
+	if endonym, ok := endonyms[toLowerASCII(lang.ISO639Set3)]; ok {
+		return endonym
+	}
+	if endonym, ok := endonyms[toLowerASCII(lang.ISO639Set1)]; ok {
+		return endonym
+	}
+	return lang.Name
+}
@@ -0,0 +1,38 @@
+package slang_test
+
+import (
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestEndonym(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	tests := map[string]string{
+		"fr-FR": "Français",
+		"zh-TW": "中文",
+		"ru":    "Русский",
+		"en-US": "English",
+		"de-CH": "Deutsch",
+		"fil":   "Filipino",
+		"haw":   "ʻŌlelo Hawaiʻi",
+		"agq":   "Aghem",
+	}
+	for tag, expected := range tests {
+		lang := lp.FindByBCP47(tag)
+		if lang == nil {
+			t.Errorf("Error: FindByBCP47(%s) should not be nil", tag)
+			continue
+		}
+		if endonym := lang.Endonym(); endonym != expected {
+			t.Errorf("Error: Endonym() of %s should be '%s', got '%s'", tag, expected, endonym)
+		}
+	}
+	if lang := lp.FindByISO639Set3("yue"); lang == nil || lang.Endonym() != "粵語" {
+		t.Errorf("Error: Endonym() of yue should be '粵語'")
+	}
+}
@@ -0,0 +1,452 @@
+package slang
+
+// FamilyOf returns the ISO 639-5 family code and name of the language with the given ISO 639 code
+// (example: "sla" and "Slavic languages" for "ru").
+//
+// Case insensitive. The language is found by FindByISOCode, and the family is the most specific one,
+// so "en" will return "gmw" (West Germanic) rather than "gem" (Germanic).
+//
+// If the language or its family is unknown, it will return empty strings.
+func (p *LangParser) FamilyOf(iso639 string) (code, name string) {
+	lang := p.FindByISOCode(iso639)
+	if lang == nil {
+		return "", ""
+	}
+	code = languageFamilies[toLowerASCII(lang.ISO639Set2)]
+	return code, familyNames[code]
+}
+
+// FindAllByFamily returns all languages belonging to the ISO 639-5 family, including its subfamilies.
+//
+// Case insensitive. Result is sorted by BCP47 tag length.
+//
+// # Examples
+//  1. "gmq" (North Germanic) will return Danish, Faroese, Icelandic, Norwegian and Swedish.
+//  2. "gem" (Germanic) will also return the West Germanic languages such as English and German.
+//  3. "ine" (Indo-European) will return all Germanic, Italic, Slavic, Indo-Iranian... languages.
+func (p *LangParser) FindAllByFamily(family string) []Lang {
+	family = toLowerASCII(family)
+	results := p.Where(func(lang Lang) bool {
+		for code := languageFamilies[toLowerASCII(lang.ISO639Set2)]; code != ""; code = familyParents[code] {
+			if code == family {
+				return true
+			}
+		}
+		return false
+	})
+	sortByBCP47Tag(results)
+	return results
+}
+
+// languageFamilies maps ISO 639-2 codes of the languages in the database to their ISO 639-5 family, in lower case.
+//
+// The family is the most specific ISO 639-5 group of the language. Isolates such as Basque and Korean are not included.
+var languageFamilies = map[string]string{
+	"aar": "cus",
+	"afr": "gmw",
+	"agq": "nic",
+	"aka": "nic",
+	"amh": "sem",
+	"ara": "sem",
+	"arn": "sai",
+	"asa": "bnt",
+	"asm": "inc",
+	"ast": "roa",
+	"aze": "trk",
+	"bak": "trk",
+	"bam": "nic",
+	"bas": "bnt",
+	"bel": "sla",
+	"bem": "bnt",
+	"ben": "inc",
+	"bez": "bnt",
+	"bgc": "inc",
+	"bho": "inc",
+	"bod": "tbq",
+	"bos": "sla",
+	"bre": "cel",
+	"brx": "tbq",
+	"bul": "sla",
+	"byn": "cus",
+	"cat": "roa",
+	"ccp": "inc",
+	"ceb": "phi",
+	"ces": "sla",
+	"cgg": "bnt",
+	"che": "ccn",
+	"chr": "iro",
+	"chu": "sla",
+	"chv": "trk",
+	"ckb": "ira",
+	"cor": "cel",
+	"cos": "roa",
+	"cym": "cel",
+	"dan": "gmq",
+	"dav": "bnt",
+	"deu": "gmw",
+	"div": "inc",
+	"dje": "ssa",
+	"doi": "inc",
+	"dsb": "sla",
+	"dua": "bnt",
+	"dyo": "nic",
+	"dzo": "tbq",
+	"ebu": "bnt",
+	"ell": "grk",
+	"eng": "gmw",
+	"epo": "art",
+	"est": "fiu",
+	"ewe": "nic",
+	"ewo": "bnt",
+	"fao": "gmq",
+	"fas": "ira",
+	"fil": "phi",
+	"fin": "fiu",
+	"fra": "roa",
+	"fry": "gmw",
+	"ful": "nic",
+	"fur": "roa",
+	"gla": "cel",
+	"gle": "cel",
+	"glg": "roa",
+	"glv": "cel",
+	"grn": "tup",
+	"gsw": "gmw",
+	"guj": "inc",
+	"guz": "bnt",
+	"hau": "cdc",
+	"haw": "map",
+	"heb": "sem",
+	"hin": "inc",
+	"hrv": "sla",
+	"hsb": "sla",
+	"hun": "fiu",
+	"hye": "hyx",
+	"ibo": "nic",
+	"iii": "tbq",
+	"iku": "esx",
+	"ina": "art",
+	"ind": "map",
+	"isl": "gmq",
+	"ita": "roa",
+	"jav": "map",
+	"jgo": "nic",
+	"jmc": "bnt",
+	"jpn": "jpx",
+	"kab": "ber",
+	"kal": "esx",
+	"kam": "bnt",
+	"kan": "dra",
+	"kas": "inc",
+	"kat": "ccs",
+	"kau": "ssa",
+	"kaz": "trk",
+	"kde": "bnt",
+	"kea": "cpp",
+	"kgp": "sai",
+	"khm": "mkh",
+	"khq": "ssa",
+	"kik": "bnt",
+	"kin": "bnt",
+	"kir": "trk",
+	"kkj": "nic",
+	"kln": "sdv",
+	"kok": "inc",
+	"ksb": "bnt",
+	"ksf": "bnt",
+	"ksh": "gmw",
+	"kur": "ira",
+	"lag": "bnt",
+	"lao": "tai",
+	"lat": "itc",
+	"lav": "bat",
+	"lin": "bnt",
+	"lit": "bat",
+	"lkt": "sio",
+	"lrc": "ira",
+	"ltz": "gmw",
+	"lub": "bnt",
+	"lug": "bnt",
+	"luo": "sdv",
+	"luy": "bnt",
+	"mai": "inc",
+	"mal": "dra",
+	"mar": "inc",
+	"mas": "sdv",
+	"mer": "bnt",
+	"mfe": "cpf",
+	"mgh": "bnt",
+	"mgo": "nic",
+	"mkd": "sla",
+	"mlg": "map",
+	"mlt": "sem",
+	"mni": "tbq",
+	"moh": "iro",
+	"mon": "xgn",
+	"mri": "map",
+	"msa": "map",
+	"mua": "nic",
+	"mya": "tbq",
+	"mzn": "ira",
+	"naq": "khi",
+	"nbl": "bnt",
+	"nde": "bnt",
+	"nds": "gmw",
+	"nep": "inc",
+	"nld": "gmw",
+	"nmg": "bnt",
+	"nnh": "nic",
+	"nno": "gmq",
+	"nob": "gmq",
+	"nor": "gmq",
+	"nqo": "nic",
+	"nso": "bnt",
+	"nus": "sdv",
+	"nyn": "bnt",
+	"oci": "roa",
+	"ori": "inc",
+	"orm": "cus",
+	"oss": "ira",
+	"pan": "inc",
+	"pcm": "cpe",
+	"pol": "sla",
+	"por": "roa",
+	"prg": "bat",
+	"prs": "ira",
+	"pus": "ira",
+	"quc": "myn",
+	"que": "qwe",
+	"quz": "qwe",
+	"raj": "inc",
+	"rof": "bnt",
+	"roh": "roa",
+	"ron": "roa",
+	"run": "bnt",
+	"rus": "sla",
+	"rwk": "bnt",
+	"sag": "nic",
+	"sah": "trk",
+	"san": "inc",
+	"saq": "sdv",
+	"sat": "mun",
+	"sbp": "bnt",
+	"seh": "bnt",
+	"ses": "ssa",
+	"shi": "ber",
+	"sin": "inc",
+	"slk": "sla",
+	"slv": "sla",
+	"sma": "smi",
+	"sme": "smi",
+	"smj": "smi",
+	"smn": "smi",
+	"sms": "smi",
+	"sna": "bnt",
+	"snd": "inc",
+	"som": "cus",
+	"sot": "bnt",
+	"spa": "roa",
+	"sqi": "sqj",
+	"srd": "roa",
+	"srp": "sla",
+	"ssw": "bnt",
+	"ssy": "cus",
+	"sun": "map",
+	"swa": "bnt",
+	"swc": "bnt",
+	"swe": "gmq",
+	"syr": "sem",
+	"tam": "dra",
+	"tat": "trk",
+	"tel": "dra",
+	"teo": "sdv",
+	"tgk": "ira",
+	"tha": "tai",
+	"tig": "sem",
+	"tir": "sem",
+	"ton": "map",
+	"tsn": "bnt",
+	"tso": "bnt",
+	"tuk": "trk",
+	"tur": "trk",
+	"twq": "ssa",
+	"tzm": "ber",
+	"uig": "trk",
+	"ukr": "sla",
+	"urd": "inc",
+	"uzb": "trk",
+	"vai": "nic",
+	"ven": "bnt",
+	"vie": "mkh",
+	"vol": "art",
+	"vun": "bnt",
+	"wae": "gmw",
+	"wal": "omv",
+	"wol": "nic",
+	"xho": "bnt",
+	"xog": "bnt",
+	"yav": "bnt",
+	"yid": "gmw",
+	"yor": "nic",
+	"yrl": "tup",
+	"yue": "zhx",
+	"zgh": "ber",
+	"zho": "zhx",
+	"zul": "bnt",
+}
+
+// familyParents maps ISO 639-5 families to their parent families, for the families used by languageFamilies.
+var familyParents = map[string]string{
+	"gmw": "gem",
+	"gmq": "gem",
+	"gem": "ine",
+	"roa": "itc",
+	"itc": "ine",
+	"sla": "ine",
+	"bat": "ine",
+	"cel": "ine",
+	"inc": "iir",
+	"ira": "iir",
+	"iir": "ine",
+	"grk": "ine",
+	"sqj": "ine",
+	"hyx": "ine",
+	"smi": "fiu",
+	"fiu": "urj",
+	"sem": "afa",
+	"ber": "afa",
+	"cus": "afa",
+	"cdc": "afa",
+	"omv": "afa",
+	"bnt": "nic",
+	"sdv": "ssa",
+	"tbq": "sit",
+	"zhx": "sit",
+	"mkh": "aav",
+	"mun": "aav",
+	"phi": "map",
+	"cpe": "crp",
+	"cpf": "crp",
+	"cpp": "crp",
+}
+
+// familyNames is the ISO 639-5 table, mapping the family codes to their English names.
+//
+// See: https://www.loc.gov/standards/iso639-5/
+var familyNames = map[string]string{
+	"aav": "Austro-Asiatic languages",
+	"afa": "Afro-Asiatic languages",
+	"alg": "Algonquian languages",
+	"alv": "Atlantic-Congo languages",
+	"apa": "Apache languages",
+	"aqa": "Alacalufan languages",
+	"aql": "Algic languages",
+	"art": "Artificial languages",
+	"ath": "Athapascan languages",
+	"auf": "Arauan languages",
+	"aus": "Australian languages",
+	"awd": "Arawakan languages",
+	"azc": "Uto-Aztecan languages",
+	"bad": "Banda languages",
+	"bai": "Bamileke languages",
+	"bat": "Baltic languages",
+	"ber": "Berber languages",
+	"bih": "Bihari languages",
+	"bnt": "Bantu languages",
+	"btk": "Batak languages",
+	"cai": "Central American Indian languages",
+	"cau": "Caucasian languages",
+	"cba": "Chibchan languages",
+	"ccn": "North Caucasian languages",
+	"ccs": "South Caucasian languages",
+	"cdc": "Chadic languages",
+	"cdd": "Caddoan languages",
+	"cel": "Celtic languages",
+	"cmc": "Chamic languages",
+	"cpe": "Creoles and pidgins, English-based",
+	"cpf": "Creoles and pidgins, French-based",
+	"cpp": "Creoles and pidgins, Portuguese-based",
+	"crp": "Creoles and pidgins",
+	"csu": "Central Sudanic languages",
+	"cus": "Cushitic languages",
+	"day": "Land Dayak languages",
+	"dmn": "Mande languages",
+	"dra": "Dravidian languages",
+	"egx": "Egyptian languages",
+	"esx": "Eskimo-Aleut languages",
+	"euq": "Basque (family)",
+	"fiu": "Finno-Ugrian languages",
+	"fox": "Formosan languages",
+	"gem": "Germanic languages",
+	"gme": "East Germanic languages",
+	"gmq": "North Germanic languages",
+	"gmw": "West Germanic languages",
+	"grk": "Greek languages",
+	"hmx": "Hmong-Mien languages",
+	"hok": "Hokan languages",
+	"hyx": "Armenian (family)",
+	"iir": "Indo-Iranian languages",
+	"ijo": "Ijo languages",
+	"inc": "Indic languages",
+	"ine": "Indo-European languages",
+	"ira": "Iranian languages",
+	"iro": "Iroquoian languages",
+	"itc": "Italic languages",
+	"jpx": "Japanese (family)",
+	"kar": "Karen languages",
+	"kdo": "Kordofanian languages",
+	"khi": "Khoisan languages",
+	"kro": "Kru languages",
+	"map": "Austronesian languages",
+	"mkh": "Mon-Khmer languages",
+	"mno": "Manobo languages",
+	"mun": "Munda languages",
+	"myn": "Mayan languages",
+	"nah": "Nahuatl languages",
+	"nai": "North American Indian languages",
+	"ngf": "Trans-New Guinea languages",
+	"nic": "Niger-Kordofanian languages",
+	"nub": "Nubian languages",
+	"omq": "Oto-Manguean languages",
+	"omv": "Omotic languages",
+	"oto": "Otomian languages",
+	"paa": "Papuan languages",
+	"phi": "Philippine languages",
+	"plf": "Central Malayo-Polynesian languages",
+	"poz": "Malayo-Polynesian languages",
+	"pqe": "Eastern Malayo-Polynesian languages",
+	"pqw": "Western Malayo-Polynesian languages",
+	"pra": "Prakrit languages",
+	"qwe": "Quechuan (family)",
+	"roa": "Romance languages",
+	"sai": "South American Indian languages",
+	"sal": "Salishan languages",
+	"sdv": "Eastern Sudanic languages",
+	"sem": "Semitic languages",
+	"sgn": "sign languages",
+	"sio": "Siouan languages",
+	"sit": "Sino-Tibetan languages",
+	"sla": "Slavic languages",
+	"smi": "Sami languages",
+	"son": "Songhai languages",
+	"sqj": "Albanian languages",
+	"ssa": "Nilo-Saharan languages",
+	"syd": "Samoyedic languages",
+	"tai": "Tai languages",
+	"tbq": "Tibeto-Burman languages",
+	"trk": "Turkic languages",
+	"tup": "Tupi languages",
+	"tut": "Altaic languages",
+	"tuw": "Tungus languages",
+	"urj": "Uralic languages",
+	"wak": "Wakashan languages",
+	"wen": "Sorbian languages",
+	"xgn": "Mongolian languages",
+	"xnd": "Na-Dene languages",
+	"ypk": "Yupik languages",
+	"zhx": "Chinese (family)",
+	"zle": "East Slavic languages",
+	"zls": "South Slavic languages",
+	"zlw": "West Slavic languages",
+	"znd": "Zande languages",
+}
@@ -0,0 +1,58 @@
+package slang_test
+
+import (
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestFamilyOf(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	tests := map[string][2]string{
+		"ru":  {"sla", "Slavic languages"},
+		"en":  {"gmw", "West Germanic languages"},
+		"SWE": {"gmq", "North Germanic languages"},
+		"cmn": {"zhx", "Chinese (family)"},
+		"ar":  {"sem", "Semitic languages"},
+		"eu":  {"", ""},
+		"xx":  {"", ""},
+	}
+	for code, expected := range tests {
+		if family, name := lp.FamilyOf(code); family != expected[0] || name != expected[1] {
+			t.Errorf("Error: FamilyOf(%s) should be %v, got '%s', '%s'", code, expected, family, name)
+		}
+	}
+}
+
+func TestFindAllByFamily(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	sets := map[string]bool{}
+	for _, lang := range lp.FindAllByFamily("GMQ") {
+		sets[lang.ISO639Set1] = true
+	}
+	for _, iso := range []string{"da", "fo", "is", "nb", "nn", "sv"} {
+		if !sets[iso] {
+			t.Errorf("Error: FindAllByFamily(GMQ) should contain '%s'", iso)
+		}
+	}
+	if sets["en"] {
+		t.Errorf("Error: FindAllByFamily(GMQ) should not contain 'en'")
+	}
+
+	germanic := lp.FindAllByFamily("gem")
+	indoEuropean := lp.FindAllByFamily("ine")
+	if len(germanic) <= len(sets) || len(indoEuropean) <= len(germanic) {
+		t.Errorf("Error: FindAllByFamily should include subfamilies, got %d gem and %d ine", len(germanic), len(indoEuropean))
+	}
+	if langs := lp.FindAllByFamily("xxx"); len(langs) != 0 {
+		t.Errorf("Error: FindAllByFamily(xxx) should be empty")
+	}
+}
@@ -0,0 +1,123 @@
+package slang
+
+import (
+	"sort"
+	"strings"
+)
+
+// maxFuzzyDistance is the maximum edit distance accepted by ParseFuzzy.
+const maxFuzzyDistance = 2
+
+// ParseFuzzy tries to parse the language code like Parse, and if it fails, returns the closest language
+// by edit distance to the BCP47 tags and names of the languages (example: "eng-US" for en-US, "chinese" for zh).
+//
+// Case insensitive. The edit distance is capped at 2, and must be less than the length of the value,
+// to avoid nonsense matches of short input (example: "q" or "@").
+// If multiple languages are equally close, it will return the language picked by the BestPolicy of the parser.
+//
+// The confidence is 1 if Parse succeeds, and below 1 for fuzzy matches, decreasing with the edit distance
+// relative to the length of the value. If no value is found, it will return nil and 0.
+//
+// This is best-effort, for user input like search boxes. It should not be used for authoritative parsing.
+func (p *LangParser) ParseFuzzy(value string) (*Lang, float64) {
+	if lang := p.Parse(value); lang != nil {
+		return lang, 1
+	}
+
+	value = toLowerASCII(strings.TrimSpace(value))
+	if value == "" {
+		return nil, 0
+	}
+	best, bestDistance := []Lang{}, 0
+	for lang := range p.All() {
+		distance := fuzzyDistance(value, lang)
+		switch {
+		case distance > maxFuzzyDistance:
+			continue
+		case len(best) == 0 || distance < bestDistance:
+			best, bestDistance = []Lang{lang}, distance
+		case distance == bestDistance:
+			best = append(best, lang)
+		}
+	}
+	// A value changed entirely is not a match, such as "q" for "qu" or "@" for "aa".
+	if len(best) == 0 || bestDistance >= len(value) {
+		return nil, 0
+	}
+	sortByBCP47Tag(best)
+	return p.pickBest(best), 1 - float64(bestDistance+1)/float64(len(value)+1)
+}
+
+// Suggest returns up to n languages closest to the value by edit distance to their BCP47 tags and names,
+// such as for "did you mean" messages when Parse fails (example: "chinese" will suggest zh, zh-CN, zh-HK...).
+//
+// Case insensitive. Result is sorted by edit distance, then by BCP47 tag length,
+// and has at most one language for each BCP47 tag. Unlike ParseFuzzy, the edit distance is not capped.
+//
+// If the value is empty or n is not positive, it will return an empty slice.
+func (p *LangParser) Suggest(value string, n int) []Lang {
+	value = toLowerASCII(strings.TrimSpace(value))
+	if value == "" || n <= 0 {
+		return []Lang{}
+	}
+
+	type candidate struct {
+		lang     Lang
+		distance int
+	}
+	candidates := []candidate{}
+	for lang := range p.All() {
+		candidates = append(candidates, candidate{lang, fuzzyDistance(value, lang)})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+		return lessBy(candidates[i].lang, candidates[j].lang, SortByBCP47)
+	})
+
+	results := []Lang{}
+	seen := map[string]bool{}
+	for _, c := range candidates {
+		if tag := stdBCP47Tag(c.lang.BCP47); !seen[tag] {
+			seen[tag] = true
+			results = append(results, c.lang)
+		}
+		if len(results) == n {
+			break
+		}
+	}
+	return results
+}
+
+// fuzzyDistance returns the smallest edit distance from the lower case value to the BCP47 tag or the name of the language.
+//
+// The name is compared both in full and without the part in parentheses (example: "Chinese (Simplified)").
+func fuzzyDistance(value string, lang Lang) int {
+	name := toLowerASCII(lang.Name)
+	distance := min(editDistance(value, stdBCP47Tag(lang.BCP47)), editDistance(value, name))
+	if i := strings.Index(name, " ("); i > 0 {
+		distance = min(distance, editDistance(value, name[:i]))
+	}
+	return distance
+}
+
+// editDistance returns the Levenshtein distance between a and b, in bytes.
+func editDistance(a, b string) int {
+	row := make([]int, len(b)+1)
+	for j := range row {
+		row[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		prev := row[0]
+		row[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			prev, row[j] = row[j], min(row[j]+1, row[j-1]+1, prev+cost)
+		}
+	}
+	return row[len(b)]
+}
@@ -0,0 +1,70 @@
+package slang_test
+
+import (
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestParseFuzzy(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	if lang, confidence := lp.ParseFuzzy("fra"); lang == nil || lang.BCP47 != "fr" || confidence != 1 {
+		t.Errorf("Error: ParseFuzzy(fra) should be 'fr' with confidence 1, got %v, %v", lang, confidence)
+	}
+
+	tests := map[string]string{
+		"eng-US":  "en-US",
+		"chinese": "zh",
+		"Frnech":  "fr",
+		"englsh":  "en",
+	}
+	for value, expected := range tests {
+		lang, confidence := lp.ParseFuzzy(value)
+		if lang == nil || lang.BCP47 != expected {
+			t.Errorf("Error: ParseFuzzy(%s) should be '%s', got %v", value, expected, lang)
+		}
+		if confidence <= 0 || confidence >= 1 {
+			t.Errorf("Error: ParseFuzzy(%s) confidence should be between 0 and 1, got %v", value, confidence)
+		}
+	}
+
+	for _, value := range []string{"", "klingon", "xxxxxxxx", "1", "-", "@", "q", "b", "@@"} {
+		if lang, confidence := lp.ParseFuzzy(value); lang != nil || confidence != 0 {
+			t.Errorf("Error: ParseFuzzy(%s) should be nil with confidence 0, got %v, %v", value, lang, confidence)
+		}
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	suggestions := lp.Suggest("chinese", 3)
+	if len(suggestions) != 3 || suggestions[0].BCP47 != "zh" {
+		t.Errorf("Error: Suggest(chinese, 3) should start with 'zh', got %v", suggestions)
+	}
+	for _, lang := range suggestions {
+		if lang.ISO639Set1 != "zh" {
+			t.Errorf("Error: Suggest(chinese, 3) should only contain Chinese, got %v", lang)
+		}
+	}
+	if suggestions[0].BCP47 == suggestions[1].BCP47 {
+		t.Errorf("Error: Suggest(chinese, 3) should not contain duplicate tags")
+	}
+
+	if suggestions := lp.Suggest("en-UK", 1); len(suggestions) != 1 || suggestions[0].ISO639Set1 != "en" {
+		t.Errorf("Error: Suggest(en-UK, 1) should be a regional English, got %v", suggestions)
+	}
+	if suggestions := lp.Suggest("", 3); suggestions == nil || len(suggestions) != 0 {
+		t.Errorf("Error: Suggest(\"\", 3) should be an empty slice")
+	}
+	if suggestions := lp.Suggest("chinese", 0); len(suggestions) != 0 {
+		t.Errorf("Error: Suggest(chinese, 0) should be empty")
+	}
+}
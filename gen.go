@@ -0,0 +1,59 @@
+//go:build ignore
+
+// Command gen generates langdb_gen.go from langdb.csv, so NewParser does not parse the CSV at runtime.
+//
+// Run it with go generate after changing langdb.csv.
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	f, err := os.Open("langdb.csv")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen.go from langdb.csv; DO NOT EDIT.\n\n")
+	buf.WriteString("package slang\n\n")
+	buf.WriteString("// langDB is the embedded language database, in the order of langdb.csv.\n")
+	buf.WriteString("var langDB = []Lang{\n")
+	for i, record := range records[1:] {
+		if len(record) != 9 {
+			log.Fatalf("line %d: expected 9 fields, got %d", i+2, len(record))
+		}
+		for j := range record {
+			record[j] = strings.TrimSpace(record[j])
+		}
+		lcid, err := strconv.ParseUint(strings.TrimPrefix(strings.ToLower(record[3]), "0x"), 16, 32)
+		if err != nil {
+			log.Fatalf("line %d: invalid lcid %q", i+2, record[3])
+		}
+		fmt.Fprintf(&buf, "\t{%q, %q, 0x%04X, %q, %q, %q, %q, %q},\n",
+			record[1], record[2], lcid, record[4], record[5], record[6], record[7], record[8])
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("langdb_gen.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
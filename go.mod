@@ -1,3 +1,8 @@
 module github.com/baobao1270/slang
 
 go 1.23.3
+
+require (
+	golang.org/x/net v0.43.0
+	golang.org/x/text v0.28.0
+)
@@ -0,0 +1,34 @@
+package slang
+
+// grandfathered maps the grandfathered tags of RFC 5646 to their preferred values, in lower case.
+//
+// Grandfathered tags without a preferred value (cel-gaulish, i-default, i-enochian, i-mingo and zh-min)
+// are not included, as there is nothing to map them to.
+//
+// See: https://www.rfc-editor.org/rfc/rfc5646#section-2.2.8
+var grandfathered = map[string]string{
+	// Irregular
+	"en-gb-oed": "en-GB-oxendict",
+	"i-ami":     "ami",
+	"i-bnn":     "bnn",
+	"i-hak":     "hak",
+	"i-klingon": "tlh",
+	"i-lux":     "lb",
+	"i-navajo":  "nv",
+	"i-pwn":     "pwn",
+	"i-tao":     "tao",
+	"i-tay":     "tay",
+	"i-tsu":     "tsu",
+	"sgn-be-fr": "sfb",
+	"sgn-be-nl": "vgt",
+	"sgn-ch-de": "sgg",
+
+	// Regular
+	"art-lojban": "jbo",
+	"no-bok":     "nb",
+	"no-nyn":     "nn",
+	"zh-guoyu":   "cmn",
+	"zh-hakka":   "hak",
+	"zh-min-nan": "nan",
+	"zh-xiang":   "hsn",
+}
@@ -0,0 +1,36 @@
+package slang_test
+
+import (
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestParseGrandfathered(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	tests := map[string]string{
+		"zh-min-nan": "nan",
+		"zh-guoyu":   "cmn",
+		"ZH_hakka":   "hak",
+		"no-bok":     "nob",
+		"no-nyn":     "nno",
+		"i-lux":      "ltz",
+	}
+	for tag, expected := range tests {
+		if lang := lp.Parse(tag); lang == nil || lang.ISO639Set3 != expected {
+			t.Errorf("Error: Parse(%s) should be '%s', got %v", tag, expected, lang)
+		}
+	}
+
+	if lang := lp.Parse("i-klingon"); lang != nil {
+		t.Errorf("Error: Parse(i-klingon) should be nil")
+	}
+	lp.AddCustom(slang.Lang{Name: "Klingon", BCP47: "tlh", ISO639Set1: "tlh", ISO639Set2: "tlh", ISO639Set3: "tlh"})
+	if lang := lp.Parse("i-klingon"); lang == nil || lang.BCP47 != "tlh" {
+		t.Errorf("Error: Parse(i-klingon) should be 'tlh'")
+	}
+}
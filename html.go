@@ -0,0 +1,45 @@
+package slang
+
+import (
+	"io"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// ExtractFromHTML scans an HTML document and returns languages of all lang and xml:lang attributes found in it.
+//
+// Attribute values are resolved by Parse. Values that cannot be resolved (including empty values) are ignored.
+// Each language is returned only once, in the order of its first appearance in the document.
+//
+// If the document cannot be read, it will return the error from the reader.
+func (p *LangParser) ExtractFromHTML(r io.Reader) ([]*Lang, error) {
+	results := []*Lang{}
+	seen := map[Lang]bool{}
+	z := html.NewTokenizer(r)
+
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			if err := z.Err(); err != io.EOF {
+				return nil, err
+			}
+			return results, nil
+		case html.StartTagToken, html.SelfClosingTagToken:
+			_, hasAttr := z.TagName()
+			for hasAttr {
+				var key, val []byte
+				key, val, hasAttr = z.TagAttr()
+				if name := string(key); name != "lang" && name != "xml:lang" {
+					continue
+				}
+				lang := p.Parse(strings.TrimSpace(string(val)))
+				if lang == nil || seen[*lang] {
+					continue
+				}
+				seen[*lang] = true
+				results = append(results, lang)
+			}
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package slang_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestExtractFromHTML(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	doc := `<!DOCTYPE html>
+<html lang="en-US">
+<head><title>Test</title></head>
+<body>
+	<p lang="fr">Bonjour</p>
+	<p LANG="zh_TW">你好</p>
+	<svg><text xml:lang="fr">Bonjour</text></svg>
+	<p lang="">Unknown</p>
+	<p lang="invalid">Invalid</p>
+	<p lang="EN-us">Hello again</p>
+	<img lang="de-DE" />
+</body>
+</html>`
+
+	langs, err := lp.ExtractFromHTML(strings.NewReader(doc))
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	expected := []string{"en-US", "fr", "zh-TW", "de-DE"}
+	if len(langs) != len(expected) {
+		t.Fatalf("Error: ExtractFromHTML should find %d languages, got %d", len(expected), len(langs))
+	}
+	for i, tag := range expected {
+		if langs[i].BCP47 != tag {
+			t.Errorf("Error: ExtractFromHTML()[%d] should be '%s', got '%s'", i, tag, langs[i].BCP47)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package slang
+
+// langField is a searchable field of Lang.
+type langField int
+
+const (
+	fieldBCP47 langField = iota
+	fieldWinID
+	fieldISO639Set1
+	fieldISO639Set2
+	fieldISO639Set3
+	fieldLocation
+)
+
+var langFields = []langField{fieldBCP47, fieldWinID, fieldISO639Set1, fieldISO639Set2, fieldISO639Set3, fieldLocation}
+
+func (f langField) of(lang Lang) string {
+	switch f {
+	case fieldBCP47:
+		return lang.BCP47
+	case fieldWinID:
+		return lang.WinID
+	case fieldISO639Set1:
+		return lang.ISO639Set1
+	case fieldISO639Set2:
+		return lang.ISO639Set2
+	case fieldISO639Set3:
+		return lang.ISO639Set3
+	case fieldLocation:
+		return lang.Location
+	}
+	return ""
+}
+
+// langIndex holds hash indexes of the language database.
+//
+// All indexes point to positions of LangParser.data, in database order.
+type langIndex struct {
+	// Lowercased field value to positions, for each field.
+	fields map[langField]map[string][]int
+
+	// Lowercased BCP47 tag to positions of all its descendant tags (example: "zh" to "zh-CN", "zh-Hans-CN", ...).
+	descendants map[string][]int
+
+	// MSLCID to positions.
+	lcid map[uint32][]int
+}
+
+func newLangIndex(data []Lang) *langIndex {
+	idx := &langIndex{
+		fields:      make(map[langField]map[string][]int),
+		descendants: make(map[string][]int),
+		lcid:        make(map[uint32][]int),
+	}
+	for _, field := range langFields {
+		idx.fields[field] = make(map[string][]int)
+	}
+	for pos, lang := range data {
+		idx.add(pos, lang)
+	}
+	return idx
+}
+
+func (idx *langIndex) add(pos int, lang Lang) {
+	for _, field := range langFields {
+		key := toLowerASCII(field.of(lang))
+		idx.fields[field][key] = append(idx.fields[field][key], pos)
+	}
+
+	tag := toLowerASCII(lang.BCP47)
+	for i := range tag {
+		if tag[i] == '-' {
+			idx.descendants[tag[:i]] = append(idx.descendants[tag[:i]], pos)
+		}
+	}
+
+	idx.lcid[lang.MSLCID] = append(idx.lcid[lang.MSLCID], pos)
+}
+
+// WithIndex builds hash indexes for the parser, so lookups no longer scan the whole database.
+//
+// Indexes are kept up to date when languages are added to the parser.
+// It is recommended for parsers resolving a large amount of values, at the cost of extra memory.
+func (p *LangParser) WithIndex() *LangParser {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.index = newLangIndex(p.data)
+	return p
+}
+
+func appendAt(results []Lang, data []Lang, positions []int) []Lang {
+	for _, pos := range positions {
+		results = append(results, data[pos])
+	}
+	return results
+}
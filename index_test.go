@@ -0,0 +1,179 @@
+package slang_test
+
+import (
+	"bytes"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestIndexMatchesScan(t *testing.T) {
+	scan, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	indexed, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	indexed.WithIndex()
+
+	for _, lang := range scan.Entries() {
+		for _, value := range []string{lang.BCP47, lang.WinID, lang.ISO639Set1, lang.ISO639Set2, lang.ISO639Set3} {
+			if !reflect.DeepEqual(scan.FindAllByBCP47(value), indexed.FindAllByBCP47(value)) {
+				t.Errorf("Error: indexed FindAllByBCP47(%s) differs from scan", value)
+			}
+			if !reflect.DeepEqual(scan.FindAllByWinID(value), indexed.FindAllByWinID(value)) {
+				t.Errorf("Error: indexed FindAllByWinID(%s) differs from scan", value)
+			}
+			if !reflect.DeepEqual(scan.FindAllByISOCode(value), indexed.FindAllByISOCode(value)) {
+				t.Errorf("Error: indexed FindAllByISOCode(%s) differs from scan", value)
+			}
+		}
+		if !reflect.DeepEqual(scan.FindAllByMSLCID(lang.MSLCID), indexed.FindAllByMSLCID(lang.MSLCID)) {
+			t.Errorf("Error: indexed FindAllByMSLCID(%#04x) differs from scan", lang.MSLCID)
+		}
+	}
+}
+
+func TestIndexAddCustom(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	lp.WithIndex().AddCustom(slang.Lang{
+		Name:       "Klingon",
+		BCP47:      "kg-SU",
+		WinID:      "KLI",
+		ISO639Set1: "kg",
+		ISO639Set2: "tlh",
+		ISO639Set3: "tlh",
+	})
+
+	if lp.Parse("KG_su").Name != "Klingon" {
+		t.Errorf("Error: Custom language 'Klingon' not found in index (parse: kg-SU)")
+	}
+	if lp.Parse("kli").Name != "Klingon" {
+		t.Errorf("Error: Custom language 'Klingon' not found in index (parse: KLI)")
+	}
+	if langs := lp.FindAllByBCP47("kg"); len(langs) != 1 || langs[0].BCP47 != "kg-SU" {
+		t.Errorf("Error: FindAllByBCP47(kg) should find descendant 'kg-SU' in index")
+	}
+}
+
+var benchmarkCodes = []string{"en-US", "zh-Hant-TW", "fr", "CHS", "eng", "wuu", "bho-Deva-IN", "invalid"}
+
+func BenchmarkParse(b *testing.B) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		b.Errorf("Error: %v", err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		lp.Parse(benchmarkCodes[i%len(benchmarkCodes)])
+	}
+}
+
+func BenchmarkParseIndexed(b *testing.B) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		b.Errorf("Error: %v", err)
+	}
+	lp.WithIndex()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		lp.Parse(benchmarkCodes[i%len(benchmarkCodes)])
+	}
+}
+
+func BenchmarkFindAllByBCP47(b *testing.B) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		b.Errorf("Error: %v", err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		lp.FindAllByBCP47(benchmarkCodes[i%len(benchmarkCodes)])
+	}
+}
+
+func BenchmarkFindAllByBCP47Indexed(b *testing.B) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		b.Errorf("Error: %v", err)
+	}
+	lp.WithIndex()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		lp.FindAllByBCP47(benchmarkCodes[i%len(benchmarkCodes)])
+	}
+}
+
+func BenchmarkFindAllByISO639Set1(b *testing.B) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		b.Errorf("Error: %v", err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		lp.FindAllByISO639Set1([]string{"en", "zh", "ar", "es"}[i%4])
+	}
+}
+
+func BenchmarkFindAllByISO639Set1Indexed(b *testing.B) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		b.Errorf("Error: %v", err)
+	}
+	lp.WithIndex()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		lp.FindAllByISO639Set1([]string{"en", "zh", "ar", "es"}[i%4])
+	}
+}
+
+func BenchmarkIsValidWinID(b *testing.B) {
+	ids := []string{"ENU", "CHS", "zzZ", "EN", "ZZ1"}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		slang.IsValidWinID(ids[i%len(ids)])
+	}
+}
+
+func BenchmarkNewParser(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := slang.NewParser(); err != nil {
+			b.Errorf("Error: %v", err)
+		}
+	}
+}
+
+func BenchmarkNewParserFromReader(b *testing.B) {
+	csv, err := os.ReadFile("langdb.csv")
+	if err != nil {
+		b.Fatalf("Error: %v", err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := slang.NewParserFromReader(bytes.NewReader(csv)); err != nil {
+			b.Errorf("Error: %v", err)
+		}
+	}
+}
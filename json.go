@@ -0,0 +1,116 @@
+package slang
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// lcidJSON is the JSON form of MSLCID.
+//
+// It is encoded as a 4-digit hex string, and can be decoded from either a hex string or a plain number.
+type lcidJSON uint32
+
+func (id lcidJSON) MarshalJSON() ([]byte, error) {
+	return json.Marshal(formatLCID(uint32(id)))
+}
+
+func (id *lcidJSON) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	value, base := string(data), 10
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		base = 0
+	}
+
+	lcid, err := strconv.ParseUint(value, base, 32)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidLCID, data)
+	}
+	*id = lcidJSON(lcid)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+//
+// MSLCID is encoded as a 4-digit hex string (example: "0x0409").
+func (lang Lang) MarshalJSON() ([]byte, error) {
+	type plain Lang
+	return json.Marshal(struct {
+		plain
+		MSLCID lcidJSON `json:"lcid"`
+	}{plain(lang), lcidJSON(lang.MSLCID)})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+//
+// MSLCID can be either a hex string (example: "0x0409") or a plain number (example: 1033).
+func (lang *Lang) UnmarshalJSON(data []byte) error {
+	type plain Lang
+	aux := struct {
+		*plain
+		MSLCID lcidJSON `json:"lcid"`
+	}{(*plain)(lang), lcidJSON(lang.MSLCID)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	lang.MSLCID = uint32(aux.MSLCID)
+	return nil
+}
+
+// WriteJSON writes all languages known by the parser (including custom ones) to w as a JSON array, in database order.
+//
+// Languages are encoded one by one as they are written, so the whole array is never held in memory.
+// The output is followed by a newline, like json.Encoder.
+func (p *LangParser) WriteJSON(w io.Writer) error {
+	return p.WriteJSONIndent(w, "", "")
+}
+
+// WriteJSONIndent is like WriteJSON but pretty-prints the output, like json.MarshalIndent.
+func (p *LangParser) WriteJSONIndent(w io.Writer, prefix, indent string) error {
+	bw := bufio.NewWriter(w)
+	pretty := prefix != "" || indent != ""
+
+	var buf bytes.Buffer
+	sep := "["
+	for lang := range p.All() {
+		data, err := json.Marshal(lang)
+		if err != nil {
+			return err
+		}
+
+		buf.Reset()
+		buf.WriteString(sep)
+		if pretty {
+			buf.WriteString("\n" + prefix + indent)
+			if err := json.Indent(&buf, data, prefix+indent, indent); err != nil {
+				return err
+			}
+		} else {
+			buf.Write(data)
+		}
+		if _, err := bw.Write(buf.Bytes()); err != nil {
+			return err
+		}
+		sep = ","
+	}
+
+	switch {
+	case sep == "[":
+		bw.WriteString("[]\n")
+	case pretty:
+		bw.WriteString("\n" + prefix + "]\n")
+	default:
+		bw.WriteString("]\n")
+	}
+	return bw.Flush()
+}
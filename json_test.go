@@ -0,0 +1,146 @@
+package slang_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	data, err := json.Marshal(lp.FindByBCP47("en-US"))
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	for _, field := range []string{
+		`"name":"English"`,
+		`"location":"United States"`,
+		`"lcid":"0x0409"`,
+		`"bcp47":"en-US"`,
+		`"win_id":"ENU"`,
+		`"iso639_1":"en"`,
+		`"iso639_2":"eng"`,
+		`"iso639_3":"eng"`,
+	} {
+		if !strings.Contains(string(data), field) {
+			t.Errorf("Error: JSON of en-US should contain %s, got %s", field, data)
+		}
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	for _, lang := range lp.Entries() {
+		data, err := json.Marshal(lang)
+		if err != nil {
+			t.Errorf("Error: %v", err)
+		}
+
+		var decoded slang.Lang
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Errorf("Error: %v", err)
+		}
+		if decoded != lang {
+			t.Errorf("Error: JSON round trip of %v should be equal, got %v", lang, decoded)
+		}
+	}
+}
+
+func TestUnmarshalJSONLCID(t *testing.T) {
+	var lang slang.Lang
+	if err := json.Unmarshal([]byte(`{"bcp47":"en-US","lcid":1033}`), &lang); err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	if lang.MSLCID != 0x0409 || lang.BCP47 != "en-US" {
+		t.Errorf("Error: Unmarshal with numeric lcid should be {0x0409, en-US}, got %v", lang)
+	}
+
+	if err := json.Unmarshal([]byte(`{"lcid":"0x0c04"}`), &lang); err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	if lang.MSLCID != 0x0C04 {
+		t.Errorf("Error: Unmarshal with hex lcid should be 0x0C04, got 0x%04X", lang.MSLCID)
+	}
+
+	err := json.Unmarshal([]byte(`{"lcid":"0xZZZZ"}`), &lang)
+	if !errors.Is(err, slang.ErrInvalidLCID) {
+		t.Errorf("Error: Unmarshal with invalid lcid should return ErrInvalidLCID, got %v", err)
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	lp.AddCustom(slang.Lang{Name: "Klingon", BCP47: "kg-SU"})
+
+	expected, err := json.Marshal(lp.Entries())
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := lp.WriteJSON(&buf); err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	if buf.String() != string(expected)+"\n" {
+		t.Errorf("Error: WriteJSON() should be equal to json.Marshal(Entries())")
+	}
+
+	var langs []slang.Lang
+	if err := json.Unmarshal(buf.Bytes(), &langs); err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	if len(langs) != lp.Len() || langs[len(langs)-1].Name != "Klingon" {
+		t.Errorf("Error: WriteJSON() should contain all languages")
+	}
+}
+
+func TestWriteJSONIndent(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	expected, err := json.MarshalIndent(lp.Entries(), "> ", "  ")
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := lp.WriteJSONIndent(&buf, "> ", "  "); err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	if buf.String() != string(expected)+"\n" {
+		t.Errorf("Error: WriteJSONIndent() should be equal to json.MarshalIndent(Entries())")
+	}
+}
+
+func TestWriteJSONEmpty(t *testing.T) {
+	lp, err := slang.NewParserFromReader(strings.NewReader(""))
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := lp.WriteJSONIndent(&buf, "", "  "); err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	if buf.String() != "[]\n" {
+		t.Errorf("Error: WriteJSONIndent() of empty parser should be '[]', got %q", buf.String())
+	}
+}
@@ -0,0 +1,1114 @@
+// Code generated by gen.go from langdb.csv; DO NOT EDIT.
+
+package slang
+
+// langDB is the embedded language database, in the order of langdb.csv.
+var langDB = []Lang{
+	{"Afar", "", 0x1000, "aa", "ZZZ", "aa", "aar", "aar"},
+	{"Afar", "Djibouti", 0x1000, "aa-DJ", "ZZZ", "aa", "aar", "aar"},
+	{"Afar", "Eritrea", 0x1000, "aa-ER", "ZZZ", "aa", "aar", "aar"},
+	{"Afar", "Ethiopia", 0x1000, "aa-ET", "ZZZ", "aa", "aar", "aar"},
+	{"Afrikaans", "", 0x0036, "af", "AFK", "af", "afr", "afr"},
+	{"Afrikaans", "Namibia", 0x1000, "af-NA", "ZZZ", "af", "afr", "afr"},
+	{"Afrikaans", "South Africa", 0x0436, "af-ZA", "AFK", "af", "afr", "afr"},
+	{"Aghem", "", 0x1000, "agq", "ZZZ", "agq", "agq", "agq"},
+	{"Aghem", "Cameroon", 0x1000, "agq-CM", "ZZZ", "agq", "agq", "agq"},
+	{"Akan", "", 0x1000, "ak", "ZZZ", "ak", "aka", "aka"},
+	{"Akan", "Ghana", 0x1000, "ak-GH", "ZZZ", "ak", "aka", "aka"},
+	{"Albanian", "", 0x001C, "sq", "SQI", "sq", "sqi", "sqi"},
+	{"Albanian", "Albania", 0x041C, "sq-AL", "SQI", "sq", "sqi", "sqi"},
+	{"Albanian", "North Macedonia", 0x1000, "sq-MK", "ZZZ", "sq", "sqi", "sqi"},
+	{"Alsatian", "", 0x0084, "gsw", "ZZZ", "gsw", "gsw", "gsw"},
+	{"Alsatian", "France", 0x0484, "gsw-FR", "GSW", "gsw", "gsw", "gsw"},
+	{"Alsatian", "Liechtenstein", 0x1000, "gsw-LI", "ZZZ", "gsw", "gsw", "gsw"},
+	{"Alsatian", "Switzerland", 0x1000, "gsw-CH", "ZZZ", "gsw", "gsw", "gsw"},
+	{"Amharic", "", 0x005E, "am", "AMH", "am", "amh", "amh"},
+	{"Amharic", "Ethiopia", 0x045E, "am-ET", "AMH", "am", "amh", "amh"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "ara"},
+	{"Arabic", "Algeria", 0x1401, "ar-DZ", "ARG", "ar", "ara", "ara"},
+	{"Arabic", "Bahrain", 0x3C01, "ar-BH", "ARH", "ar", "ara", "ara"},
+	{"Arabic", "Chad", 0x1000, "ar-TD", "ZZZ", "ar", "ara", "ara"},
+	{"Arabic", "Comoros", 0x1000, "ar-KM", "ZZZ", "ar", "ara", "ara"},
+	{"Arabic", "Djibouti", 0x1000, "ar-DJ", "ZZZ", "ar", "ara", "ara"},
+	{"Arabic", "Egypt", 0x0C01, "ar-EG", "ARE", "ar", "ara", "ara"},
+	{"Arabic", "Eritrea", 0x1000, "ar-ER", "ZZZ", "ar", "ara", "ara"},
+	{"Arabic", "Iraq", 0x0801, "ar-IQ", "ARI", "ar", "ara", "ara"},
+	{"Arabic", "Israel", 0x1000, "ar-IL", "ZZZ", "ar", "ara", "ara"},
+	{"Arabic", "Jordan", 0x2C01, "ar-JO", "ARJ", "ar", "ara", "ara"},
+	{"Arabic", "Kuwait", 0x3401, "ar-KW", "ARK", "ar", "ara", "ara"},
+	{"Arabic", "Lebanon", 0x3001, "ar-LB", "ARB", "ar", "ara", "ara"},
+	{"Arabic", "Libya", 0x1001, "ar-LY", "ARL", "ar", "ara", "ara"},
+	{"Arabic", "Mauritania", 0x1000, "ar-MR", "ZZZ", "ar", "ara", "ara"},
+	{"Arabic", "Morocco", 0x1801, "ar-MA", "ARM", "ar", "ara", "ara"},
+	{"Arabic", "tzm-Arab-", 0x2001, "ar-OM", "ARO", "ar", "ara", "ara"},
+	{"Arabic", "Palestinian Authority", 0x1000, "ar-PS", "ZZZ", "ar", "ara", "ara"},
+	{"Arabic", "Qatar", 0x4001, "ar-QA", "ARQ", "ar", "ara", "ara"},
+	{"Arabic", "Saudi Arabia", 0x0401, "ar-SA", "ARA", "ar", "ara", "ara"},
+	{"Arabic", "Somalia", 0x1000, "ar-SO", "ZZZ", "ar", "ara", "ara"},
+	{"Arabic", "South Sudan", 0x1000, "ar-SS", "ZZZ", "ar", "ara", "ara"},
+	{"Arabic", "Sudan", 0x1000, "ar-SD", "ZZZ", "ar", "ara", "ara"},
+	{"Arabic", "Syria", 0x2801, "ar-SY", "ARS", "ar", "ara", "ara"},
+	{"Arabic", "Tunisia", 0x1C01, "ar-TN", "ART", "ar", "ara", "ara"},
+	{"Arabic", "U.A.E.", 0x3801, "ar-AE", "ARU", "ar", "ara", "ara"},
+	{"Arabic", "World", 0x1000, "ar-001", "ZZZ", "ar", "ara", "ara"},
+	{"Arabic", "Yemen", 0x2401, "ar-YE", "ARY", "ar", "ara", "ara"},
+	{"Armenian", "", 0x002B, "hy", "HYE", "hy", "hye", "hye"},
+	{"Armenian", "Armenia", 0x042B, "hy-AM", "HYE", "hy", "hye", "hye"},
+	{"Assamese", "", 0x004D, "as", "ASM", "as", "asm", "asm"},
+	{"Assamese", "India", 0x044D, "as-IN", "ASM", "as", "asm", "asm"},
+	{"Asturian", "", 0x1000, "ast", "ZZZ", "ast", "ast", "ast"},
+	{"Asturian", "Spain", 0x1000, "ast-ES", "ZZZ", "ast", "ast", "ast"},
+	{"Asu", "", 0x1000, "asa", "ZZZ", "asa", "asa", "asa"},
+	{"Asu", "Tanzania", 0x1000, "asa-TZ", "ZZZ", "asa", "asa", "asa"},
+	{"Azerbaijani (Cyrillic)", "", 0x742C, "az-Cyrl", "AZC", "az", "aze", "aze"},
+	{"Azerbaijani (Cyrillic)", "Azerbaijan", 0x082C, "az-Cyrl-AZ", "AZC", "az", "aze", "aze"},
+	{"Azerbaijani (Latin)", "", 0x002C, "az", "AZE", "az", "aze", "aze"},
+	{"Azerbaijani (Latin)", "", 0x782C, "az-Latn", "AZE", "az", "aze", "aze"},
+	{"Azerbaijani (Latin)", "Azerbaijan", 0x042C, "az-Latn-AZ", "AZE", "az", "aze", "aze"},
+	{"Bafia", "", 0x1000, "ksf", "ZZZ", "ksf", "ksf", "ksf"},
+	{"Bafia", "Cameroon", 0x1000, "ksf-CM", "ZZZ", "ksf", "ksf", "ksf"},
+	{"Bamanankan", "", 0x1000, "bm", "ZZZ", "bm", "bam", "bam"},
+	{"Bamanankan (Latin)", "Mali", 0x1000, "bm-Latn-ML", "ZZZ", "bm", "bam", "bam"},
+	{"Bangla", "", 0x0045, "bn", "BNB", "bn", "ben", "ben"},
+	{"Bangla", "Bangladesh", 0x0845, "bn-BD", "BNB", "bn", "ben", "ben"},
+	{"Bangla", "India", 0x0445, "bn-IN", "BNG", "bn", "ben", "ben"},
+	{"Basaa", "", 0x1000, "bas", "ZZZ", "bas", "bas", "bas"},
+	{"Basaa", "Cameroon", 0x1000, "bas-CM", "ZZZ", "bas", "bas", "bas"},
+	{"Bashkir", "", 0x006D, "ba", "BAS", "ba", "bak", "bak"},
+	{"Bashkir", "Russia", 0x046D, "ba-RU", "BAS", "ba", "bak", "bak"},
+	{"Basque", "", 0x002D, "eu", "EUQ", "eu", "eus", "eus"},
+	{"Basque", "Spain", 0x042D, "eu-ES", "EUQ", "eu", "eus", "eus"},
+	{"Belarusian", "", 0x0023, "be", "BEL", "be", "bel", "bel"},
+	{"Belarusian", "Belarus", 0x0423, "be-BY", "BEL", "be", "bel", "bel"},
+	{"Bemba", "", 0x1000, "bem", "ZZZ", "bem", "bem", "bem"},
+	{"Bemba", "Zambia", 0x1000, "bem-ZM", "ZZZ", "bem", "bem", "bem"},
+	{"Bena", "", 0x1000, "bez", "ZZZ", "bez", "bez", "bez"},
+	{"Bena", "Tanzania", 0x1000, "bez-TZ", "ZZZ", "bez", "bez", "bez"},
+	{"Bhojpuri", "", 0x1000, "bho", "ZZZ", "bho", "bho", "bho"},
+	{"Bhojpuri (Devanagari)", "", 0x1000, "bho-Deva", "ZZZ", "bho", "bho", "bho"},
+	{"Bhojpuri (Devanagari)", "India", 0x1000, "bho-Deva-IN", "ZZZ", "bho", "bho", "bho"},
+	{"Blin", "", 0x1000, "byn", "ZZZ", "byn", "byn", "byn"},
+	{"Blin", "Eritrea", 0x1000, "byn-ER", "ZZZ", "byn", "byn", "byn"},
+	{"Bodo", "", 0x1000, "brx", "ZZZ", "brx", "brx", "brx"},
+	{"Bodo", "India", 0x1000, "brx-IN", "ZZZ", "brx", "brx", "brx"},
+	{"Bosnian (Cyrillic)", "", 0x641A, "bs-Cyrl", "BSC", "bs", "bos", "bos"},
+	{"Bosnian (Cyrillic)", "Bosnia and Herzegovina", 0x201A, "bs-Cyrl-BA", "BSC", "bs", "bos", "bos"},
+	{"Bosnian (Latin)", "", 0x681A, "bs-Latn", "BSB", "bs", "bos", "bos"},
+	{"Bosnian (Latin)", "", 0x781A, "bs", "BSB", "bs", "bos", "bos"},
+	{"Bosnian (Latin)", "Bosnia and Herzegovina", 0x141A, "bs-Latn-BA", "BSB", "bs", "bos", "bos"},
+	{"Breton", "", 0x007E, "br", "BRE", "br", "bre", "bre"},
+	{"Breton", "France", 0x047E, "br-FR", "BRE", "br", "bre", "bre"},
+	{"Bulgarian", "", 0x0002, "bg", "BGR", "bg", "bul", "bul"},
+	{"Bulgarian", "Bulgaria", 0x0402, "bg-BG", "BGR", "bg", "bul", "bul"},
+	{"Burmese", "", 0x0055, "my", "MYA", "my", "mya", "mya"},
+	{"Burmese", "Myanmar", 0x0455, "my-MM", "MYA", "my", "mya", "mya"},
+	{"Catalan", "", 0x0003, "ca", "CAT", "ca", "cat", "cat"},
+	{"Catalan", "Andorra", 0x1000, "ca-AD", "ZZZ", "ca", "cat", "cat"},
+	{"Catalan", "France", 0x1000, "ca-FR", "ZZZ", "ca", "cat", "cat"},
+	{"Catalan", "Italy", 0x1000, "ca-IT", "ZZZ", "ca", "cat", "cat"},
+	{"Catalan", "Spain", 0x0403, "ca-ES", "CAT", "ca", "cat", "cat"},
+	{"Cebuano", "", 0x1000, "ceb", "ZZZ", "ceb", "ceb", "ceb"},
+	{"Cebuan (Latin)", "", 0x1000, "ceb-Latn", "ZZZ", "ceb", "ceb", "ceb"},
+	{"Cebuan (Latin)", "Philippines", 0x1000, "ceb-Latn-PH", "ZZZ", "ceb", "ceb", "ceb"},
+	{"Central Atlas Tamazight (Arabic)", "Morocco", 0x045F, "tzm-Arab-MA", "ZZZ", "tzm", "tzm", "tzm"},
+	{"Central Atlas Tamazight (Latin)", "Morocco", 0x1000, "tzm-Latn-MA", "ZZZ", "tzm", "tzm", "tzm"},
+	{"Central Kurdish", "", 0x0092, "ku", "KUR", "ku", "kur", "kur"},
+	{"Central Kurdish", "", 0x7C92, "ku-Arab", "KUR", "ku", "kur", "kur"},
+	{"Central Kurdish", "Iraq", 0x0492, "ku-Arab-IQ", "KUR", "ku", "kur", "kur"},
+	{"Chakma", "", 0x1000, "ccp", "ZZZ", "ccp", "ccp", "ccp"},
+	{"Chakma", "Chakma", 0x1000, "ccp-Cakm", "ZZZ", "ccp", "ccp", "ccp"},
+	{"Chakma", "Bangladesh", 0x1000, "ccp-Cakm-BD", "ZZZ", "ccp", "ccp", "ccp"},
+	{"Chakma", "India", 0x1000, "ccp-Cakm-IN", "ZZZ", "ccp", "ccp", "ccp"},
+	{"Chechen", "Russia", 0x1000, "ce-RU", "ZZZ", "ce", "che", "che"},
+	{"Cherokee", "", 0x005C, "chr", "CRE", "chr", "chr", "chr"},
+	{"Cherokee", "", 0x7C5C, "chr-Cher", "CRE", "chr", "chr", "chr"},
+	{"Cherokee", "United States", 0x045C, "chr-Cher-US", "CRE", "chr", "chr", "chr"},
+	{"Chiga", "", 0x1000, "cgg", "ZZZ", "cgg", "cgg", "cgg"},
+	{"Chiga", "Uganda", 0x1000, "cgg-UG", "ZZZ", "cgg", "cgg", "cgg"},
+	{"Chinese (Simplified)", "", 0x0004, "zh-Hans", "CHS", "zh", "zho", "zho"},
+	{"Chinese (Simplified)", "", 0x7804, "zh", "CHS", "zh", "zho", "zho"},
+	{"Chinese (Simplified)", "People's Republic of China", 0x0804, "zh-CN", "CHS", "zh", "zho", "zho"},
+	{"Chinese (Simplified)", "Singapore", 0x1004, "zh-SG", "ZHI", "zh", "zho", "zho"},
+	{"Chinese (Traditional)", "", 0x7C04, "zh-Hant", "ZHH", "zh", "zho", "zho"},
+	{"Chinese (Traditional)", "Hong Kong S.A.R.", 0x0C04, "zh-HK", "ZHH", "zh", "zho", "zho"},
+	{"Chinese (Traditional)", "Macao S.A.R.", 0x1404, "zh-MO", "ZHM", "zh", "zho", "zho"},
+	{"Chinese (Traditional)", "Taiwan", 0x0404, "zh-TW", "CHT", "zh", "zho", "zho"},
+	{"Church Slavic", "Russia", 0x1000, "cu-RU", "ZZZ", "cu", "chu", "chu"},
+	{"Chuvash", "", 0x1000, "cv", "ZZZ", "cv", "chv", "chv"},
+	{"Chuvash (Cyrillic)", "", 0x1000, "cv-Cyrl", "ZZZ", "cv", "chv", "chv"},
+	{"Chuvash (Cyrillic)", "Russia", 0x1000, "cv-Cyrl-RU", "ZZZ", "cv", "chv", "chv"},
+	{"Congo Swahili", "", 0x1000, "swc", "ZZZ", "swc", "swc", "swc"},
+	{"Congo Swahili", "Congo DRC", 0x1000, "swc-CD", "ZZZ", "swc", "swc", "swc"},
+	{"Cornish", "", 0x1000, "kw", "ZZZ", "kw", "cor", "cor"},
+	{"Cornish", "United Kingdom", 0x1000, "kw-GB", "ZZZ", "kw", "cor", "cor"},
+	{"Corsican", "", 0x0083, "co", "COS", "co", "cos", "cos"},
+	{"Corsican", "France", 0x0483, "co-FR", "COS", "co", "cos", "cos"},
+	{"Croatian", "", 0x001A, "hr", "HRV", "hr", "hrv", "hrv"},
+	{"Croatian", "Croatia", 0x041A, "hr-HR", "HRV", "hr", "hrv", "hrv"},
+	{"Croatian (Latin)", "Bosnia and Herzegovina", 0x101A, "hr-BA", "HRB", "hr", "hrv", "hrv"},
+	{"Czech", "", 0x0005, "cs", "CSY", "cs", "ces", "ces"},
+	{"Czech", "Czech Republic", 0x0405, "cs-CZ", "CSY", "cs", "ces", "ces"},
+	{"Danish", "", 0x0006, "da", "DAN", "da", "dan", "dan"},
+	{"Danish", "Denmark", 0x0406, "da-DK", "DAN", "da", "dan", "dan"},
+	{"Danish", "Greenland", 0x1000, "da-GL", "ZZZ", "da", "dan", "dan"},
+	{"Dari", "", 0x008C, "prs", "PRS", "prs", "prs", "prs"},
+	{"Dari", "Afghanistan", 0x048C, "prs-AF", "PRS", "prs", "prs", "prs"},
+	{"Divehi", "", 0x0065, "dv", "DIV", "dv", "div", "div"},
+	{"Divehi", "Maldives", 0x0465, "dv-MV", "DIV", "dv", "div", "div"},
+	{"Duala", "", 0x1000, "dua", "ZZZ", "dua", "dua", "dua"},
+	{"Duala", "Cameroon", 0x1000, "dua-CM", "ZZZ", "dua", "dua", "dua"},
+	{"Dutch", "", 0x0013, "nl", "NLD", "nl", "nld", "nld"},
+	{"Dutch", "Aruba", 0x1000, "nl-AW", "ZZZ", "nl", "nld", "nld"},
+	{"Dutch", "Belgium", 0x0813, "nl-BE", "NLB", "nl", "nld", "nld"},
+	{"Dutch", "Bonaire, Sint Eustatius and Saba", 0x1000, "nl-BQ", "ZZZ", "nl", "nld", "nld"},
+	{"Dutch", "Curaçao", 0x1000, "nl-CW", "ZZZ", "nl", "nld", "nld"},
+	{"Dutch", "Netherlands", 0x0413, "nl-NL", "NLD", "nl", "nld", "nld"},
+	{"Dutch", "Sint Maarten", 0x1000, "nl-SX", "ZZZ", "nl", "nld", "nld"},
+	{"Dutch", "Suriname", 0x1000, "nl-SR", "ZZZ", "nl", "nld", "nld"},
+	{"Dzongkha", "", 0x1000, "dz", "ZZZ", "dz", "dzo", "dzo"},
+	{"Dzongkha", "Bhutan", 0x0C51, "dz-BT", "ZZZ", "dz", "dzo", "dzo"},
+	{"Embu", "", 0x1000, "ebu", "ZZZ", "ebu", "ebu", "ebu"},
+	{"Embu", "Kenya", 0x1000, "ebu-KE", "ZZZ", "ebu", "ebu", "ebu"},
+	{"English", "", 0x0009, "en", "ENU", "en", "eng", "eng"},
+	{"English", "American Samoa", 0x1000, "en-AS", "ZZZ", "en", "eng", "eng"},
+	{"English", "Anguilla", 0x1000, "en-AI", "ZZZ", "en", "eng", "eng"},
+	{"English", "Antigua and Barbuda", 0x1000, "en-AG", "ZZZ", "en", "eng", "eng"},
+	{"English", "Australia", 0x0C09, "en-AU", "ENA", "en", "eng", "eng"},
+	{"English", "Austria", 0x1000, "en-AT", "ZZZ", "en", "eng", "eng"},
+	{"English", "Bahamas", 0x1000, "en-BS", "ZZZ", "en", "eng", "eng"},
+	{"English", "Barbados", 0x1000, "en-BB", "ZZZ", "en", "eng", "eng"},
+	{"English", "Belgium", 0x1000, "en-BE", "ZZZ", "en", "eng", "eng"},
+	{"English", "Belize", 0x2809, "en-BZ", "ENL", "en", "eng", "eng"},
+	{"English", "Bermuda", 0x1000, "en-BM", "ZZZ", "en", "eng", "eng"},
+	{"English", "Botswana", 0x1000, "en-BW", "ZZZ", "en", "eng", "eng"},
+	{"English", "British Indian Ocean Territory", 0x1000, "en-IO", "ZZZ", "en", "eng", "eng"},
+	{"English", "British Virgin Islands", 0x1000, "en-VG", "ZZZ", "en", "eng", "eng"},
+	{"English", "Burundi", 0x1000, "en-BI", "ZZZ", "en", "eng", "eng"},
+	{"English", "Cameroon", 0x1000, "en-CM", "ZZZ", "en", "eng", "eng"},
+	{"English", "Canada", 0x1009, "en-CA", "ENC", "en", "eng", "eng"},
+	{"English", "Caribbean", 0x2409, "en-029", "ENB", "en", "eng", "eng"},
+	{"English", "Cayman Islands", 0x1000, "en-KY", "ZZZ", "en", "eng", "eng"},
+	{"English", "Christmas Island", 0x1000, "en-CX", "ZZZ", "en", "eng", "eng"},
+	{"English", "Cocos [Keeling] Islands", 0x1000, "en-CC", "ZZZ", "en", "eng", "eng"},
+	{"English", "Cook Islands", 0x1000, "en-CK", "ZZZ", "en", "eng", "eng"},
+	{"English", "Cyprus", 0x1000, "en-CY", "ZZZ", "en", "eng", "eng"},
+	{"English", "Denmark", 0x1000, "en-DK", "ZZZ", "en", "eng", "eng"},
+	{"English", "Dominica", 0x1000, "en-DM", "ZZZ", "en", "eng", "eng"},
+	{"English", "Eritrea", 0x1000, "en-ER", "ZZZ", "en", "eng", "eng"},
+	{"English", "Europe", 0x1000, "en-150", "ZZZ", "en", "eng", "eng"},
+	{"English", "Falkland Islands", 0x1000, "en-FK", "ZZZ", "en", "eng", "eng"},
+	{"English", "Finland", 0x1000, "en-FI", "ZZZ", "en", "eng", "eng"},
+	{"English", "Fiji", 0x1000, "en-FJ", "ZZZ", "en", "eng", "eng"},
+	{"English", "Gambia", 0x1000, "en-GM", "ZZZ", "en", "eng", "eng"},
+	{"English", "Germany", 0x1000, "en-DE", "ZZZ", "en", "eng", "eng"},
+	{"English", "Ghana", 0x1000, "en-GH", "ZZZ", "en", "eng", "eng"},
+	{"English", "Gibraltar", 0x1000, "en-GI", "ZZZ", "en", "eng", "eng"},
+	{"English", "Grenada", 0x1000, "en-GD", "ZZZ", "en", "eng", "eng"},
+	{"English", "Guam", 0x1000, "en-GU", "ZZZ", "en", "eng", "eng"},
+	{"English", "Guernsey", 0x1000, "en-GG", "ZZZ", "en", "eng", "eng"},
+	{"English", "Guyana", 0x1000, "en-GY", "ZZZ", "en", "eng", "eng"},
+	{"English", "Hong Kong", 0x3C09, "en-HK", "ENH", "en", "eng", "eng"},
+	{"English", "India", 0x4009, "en-IN", "ENN", "en", "eng", "eng"},
+	{"English", "Ireland", 0x1809, "en-IE", "ENI", "en", "eng", "eng"},
+	{"English", "Isle of Man", 0x1000, "en-IM", "ZZZ", "en", "eng", "eng"},
+	{"English", "Israel", 0x1000, "en-IL", "ZZZ", "en", "eng", "eng"},
+	{"English", "Jamaica", 0x2009, "en-JM", "ENJ", "en", "eng", "eng"},
+	{"English", "Jersey", 0x1000, "en-JE", "ZZZ", "en", "eng", "eng"},
+	{"English", "Kenya", 0x1000, "en-KE", "ZZZ", "en", "eng", "eng"},
+	{"English", "Kiribati", 0x1000, "en-KI", "ZZZ", "en", "eng", "eng"},
+	{"English", "Lesotho", 0x1000, "en-LS", "ZZZ", "en", "eng", "eng"},
+	{"English", "Liberia", 0x1000, "en-LR", "ZZZ", "en", "eng", "eng"},
+	{"English", "Macao SAR", 0x1000, "en-MO", "ZZZ", "en", "eng", "eng"},
+	{"English", "Madagascar", 0x1000, "en-MG", "ZZZ", "en", "eng", "eng"},
+	{"English", "Malawi", 0x1000, "en-MW", "ZZZ", "en", "eng", "eng"},
+	{"English", "Malaysia", 0x4409, "en-MY", "ENM", "en", "eng", "eng"},
+	{"English", "Maldives", 0x1000, "en-MV", "ZZZ", "en", "eng", "eng"},
+	{"English", "Malta", 0x1000, "en-MT", "ZZZ", "en", "eng", "eng"},
+	{"English", "Marshall Islands", 0x1000, "en-MH", "ZZZ", "en", "eng", "eng"},
+	{"English", "Mauritius", 0x1000, "en-MU", "ZZZ", "en", "eng", "eng"},
+	{"English", "Micronesia", 0x1000, "en-FM", "ZZZ", "en", "eng", "eng"},
+	{"English", "Montserrat", 0x1000, "en-MS", "ZZZ", "en", "eng", "eng"},
+	{"English", "Namibia", 0x1000, "en-NA", "ZZZ", "en", "eng", "eng"},
+	{"English", "Nauru", 0x1000, "en-NR", "ZZZ", "en", "eng", "eng"},
+	{"English", "Netherlands", 0x1000, "en-NL", "ZZZ", "en", "eng", "eng"},
+	{"English", "New Zealand", 0x1409, "en-NZ", "ENZ", "en", "eng", "eng"},
+	{"English", "Nigeria", 0x1000, "en-NG", "ZZZ", "en", "eng", "eng"},
+	{"English", "Niue", 0x1000, "en-NU", "ZZZ", "en", "eng", "eng"},
+	{"English", "Norfolk Island", 0x1000, "en-NF", "ZZZ", "en", "eng", "eng"},
+	{"English", "Northern Mariana Islands", 0x1000, "en-MP", "ZZZ", "en", "eng", "eng"},
+	{"English", "Pakistan", 0x1000, "en-PK", "ZZZ", "en", "eng", "eng"},
+	{"English", "Palau", 0x1000, "en-PW", "ZZZ", "en", "eng", "eng"},
+	{"English", "Papua New Guinea", 0x1000, "en-PG", "ZZZ", "en", "eng", "eng"},
+	{"English", "Pitcairn Islands", 0x1000, "en-PN", "ZZZ", "en", "eng", "eng"},
+	{"English", "Puerto Rico", 0x1000, "en-PR", "ZZZ", "en", "eng", "eng"},
+	{"English", "Republic of the Philippines", 0x3409, "en-PH", "ENP", "en", "eng", "eng"},
+	{"English", "Rwanda", 0x1000, "en-RW", "ZZZ", "en", "eng", "eng"},
+	{"English", "Saint Kitts and Nevis", 0x1000, "en-KN", "ZZZ", "en", "eng", "eng"},
+	{"English", "Saint Lucia", 0x1000, "en-LC", "ZZZ", "en", "eng", "eng"},
+	{"English", "Saint Vincent and the Grenadines", 0x1000, "en-VC", "ZZZ", "en", "eng", "eng"},
+	{"English", "Samoa", 0x1000, "en-WS", "ZZZ", "en", "eng", "eng"},
+	{"English", "Seychelles", 0x1000, "en-SC", "ZZZ", "en", "eng", "eng"},
+	{"English", "Sierra Leone", 0x1000, "en-SL", "ZZZ", "en", "eng", "eng"},
+	{"English", "Singapore", 0x4809, "en-SG", "ENE", "en", "eng", "eng"},
+	{"English", "Sint Maarten", 0x1000, "en-SX", "ZZZ", "en", "eng", "eng"},
+	{"English", "Slovenia", 0x1000, "en-SI", "ZZZ", "en", "eng", "eng"},
+	{"English", "Solomon Islands", 0x1000, "en-SB", "ZZZ", "en", "eng", "eng"},
+	{"English", "South Africa", 0x1C09, "en-ZA", "ENS", "en", "eng", "eng"},
+	{"English", "South Sudan", 0x1000, "en-SS", "ZZZ", "en", "eng", "eng"},
+	{"English", "St Helena, Ascension, Tristan da Cunha", 0x1000, "en-SH", "ZZZ", "en", "eng", "eng"},
+	{"English", "Sudan", 0x1000, "en-SD", "ZZZ", "en", "eng", "eng"},
+	{"English", "Swaziland", 0x1000, "en-SZ", "ZZZ", "en", "eng", "eng"},
+	{"English", "Sweden", 0x1000, "en-SE", "ZZZ", "en", "eng", "eng"},
+	{"English", "Switzerland", 0x1000, "en-CH", "ZZZ", "en", "eng", "eng"},
+	{"English", "Tanzania", 0x1000, "en-TZ", "ZZZ", "en", "eng", "eng"},
+	{"English", "Tokelau", 0x1000, "en-TK", "ZZZ", "en", "eng", "eng"},
+	{"English", "Tonga", 0x1000, "en-TO", "ZZZ", "en", "eng", "eng"},
+	{"English", "Trinidad and Tobago", 0x2C09, "en-TT", "ENT", "en", "eng", "eng"},
+	{"English", "Turks and Caicos Islands", 0x1000, "en-TC", "ZZZ", "en", "eng", "eng"},
+	{"English", "Tuvalu", 0x1000, "en-TV", "ZZZ", "en", "eng", "eng"},
+	{"English", "Uganda", 0x1000, "en-UG", "ZZZ", "en", "eng", "eng"},
+	{"English", "United Arab Emirates", 0x4C09, "en-AE", "ZZZ", "en", "eng", "eng"},
+	{"English", "United Kingdom", 0x0809, "en-GB", "ENG", "en", "eng", "eng"},
+	{"English", "United States", 0x0409, "en-US", "ENU", "en", "eng", "eng"},
+	{"English", "US Minor Outlying Islands", 0x1000, "en-UM", "ZZZ", "en", "eng", "eng"},
+	{"English", "US Virgin Islands", 0x1000, "en-VI", "ZZZ", "en", "eng", "eng"},
+	{"English", "Vanuatu", 0x1000, "en-VU", "ZZZ", "en", "eng", "eng"},
+	{"English", "World", 0x1000, "en-001", "ZZZ", "en", "eng", "eng"},
+	{"English", "Zambia", 0x1000, "en-ZM", "ZZZ", "en", "eng", "eng"},
+	{"English", "Zimbabwe", 0x3009, "en-ZW", "ENW", "en", "eng", "eng"},
+	{"Esperanto", "", 0x1000, "eo", "ZZZ", "eo", "epo", "epo"},
+	{"Esperanto", "World", 0x1000, "eo-001", "ZZZ", "eo", "epo", "epo"},
+	{"Estonian", "", 0x0025, "et", "ETI", "et", "est", "est"},
+	{"Estonian", "Estonia", 0x0425, "et-EE", "ETI", "et", "est", "est"},
+	{"Ewe", "", 0x1000, "ee", "ZZZ", "ee", "ewe", "ewe"},
+	{"Ewe", "Ghana", 0x1000, "ee-GH", "ZZZ", "ee", "ewe", "ewe"},
+	{"Ewe", "Togo", 0x1000, "ee-TG", "ZZZ", "ee", "ewe", "ewe"},
+	{"Ewondo", "", 0x1000, "ewo", "ZZZ", "ewo", "ewo", "ewo"},
+	{"Ewondo", "Cameroon", 0x1000, "ewo-CM", "ZZZ", "ewo", "ewo", "ewo"},
+	{"Faroese", "", 0x0038, "fo", "FOS", "fo", "fao", "fao"},
+	{"Faroese", "Denmark", 0x1000, "fo-DK", "ZZZ", "fo", "fao", "fao"},
+	{"Faroese", "Faroe Islands", 0x0438, "fo-FO", "FOS", "fo", "fao", "fao"},
+	{"Filipino", "", 0x0064, "fil", "FPO", "fil", "fil", "fil"},
+	{"Filipino", "Philippines", 0x0464, "fil-PH", "FPO", "fil", "fil", "fil"},
+	{"Finnish", "", 0x000B, "fi", "FIN", "fi", "fin", "fin"},
+	{"Finnish", "Finland", 0x040B, "fi-FI", "FIN", "fi", "fin", "fin"},
+	{"French", "", 0x000C, "fr", "FRA", "fr", "fra", "fra"},
+	{"French", "Algeria", 0x1000, "fr-DZ", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Belgium", 0x080C, "fr-BE", "FRB", "fr", "fra", "fra"},
+	{"French", "Benin", 0x1000, "fr-BJ", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Burkina Faso", 0x1000, "fr-BF", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Burundi", 0x1000, "fr-BI", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Cameroon", 0x2C0C, "fr-CM", "FRE", "fr", "fra", "fra"},
+	{"French", "Canada", 0x0C0C, "fr-CA", "FRC", "fr", "fra", "fra"},
+	{"French", "Caribbean", 0x1C0C, "fr-029", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Central African Republic", 0x1000, "fr-CF", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Chad", 0x1000, "fr-TD", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Comoros", 0x1000, "fr-KM", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Congo", 0x1000, "fr-CG", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Congo, DRC", 0x240C, "fr-CD", "FRD", "fr", "fra", "fra"},
+	{"French", "Côte d'Ivoire", 0x300C, "fr-CI", "FRI", "fr", "fra", "fra"},
+	{"French", "Djibouti", 0x1000, "fr-DJ", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Equatorial Guinea", 0x1000, "fr-GQ", "ZZZ", "fr", "fra", "fra"},
+	{"French", "France", 0x040C, "fr-FR", "FRA", "fr", "fra", "fra"},
+	{"French", "French Guiana", 0x1000, "fr-GF", "ZZZ", "fr", "fra", "fra"},
+	{"French", "French Polynesia", 0x1000, "fr-PF", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Gabon", 0x1000, "fr-GA", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Guadeloupe", 0x1000, "fr-GP", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Guinea", 0x1000, "fr-GN", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Haiti", 0x3C0C, "fr-HT", "FRH", "fr", "fra", "fra"},
+	{"French", "Luxembourg", 0x140C, "fr-LU", "FRL", "fr", "fra", "fra"},
+	{"French", "Madagascar", 0x1000, "fr-MG", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Mali", 0x340C, "fr-ML", "FRF", "fr", "fra", "fra"},
+	{"French", "Martinique", 0x1000, "fr-MQ", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Mauritania", 0x1000, "fr-MR", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Mauritius", 0x1000, "fr-MU", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Mayotte", 0x1000, "fr-YT", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Morocco", 0x380C, "fr-MA", "FRO", "fr", "fra", "fra"},
+	{"French", "New Caledonia", 0x1000, "fr-NC", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Niger", 0x1000, "fr-NE", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Principality of Monaco", 0x180C, "fr-MC", "FRM", "fr", "fra", "fra"},
+	{"French", "Reunion", 0x200C, "fr-RE", "FRR", "fr", "fra", "fra"},
+	{"French", "Rwanda", 0x1000, "fr-RW", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Saint Barthélemy", 0x1000, "fr-BL", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Saint Martin", 0x1000, "fr-MF", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Saint Pierre and Miquelon", 0x1000, "fr-PM", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Senegal", 0x280C, "fr-SN", "FRN", "fr", "fra", "fra"},
+	{"French", "Seychelles", 0x1000, "fr-SC", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Switzerland", 0x100C, "fr-CH", "FRS", "fr", "fra", "fra"},
+	{"French", "Syria", 0x1000, "fr-SY", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Togo", 0x1000, "fr-TG", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Tunisia", 0x1000, "fr-TN", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Vanuatu", 0x1000, "fr-VU", "ZZZ", "fr", "fra", "fra"},
+	{"French", "Wallis and Futuna", 0x1000, "fr-WF", "ZZZ", "fr", "fra", "fra"},
+	{"Frisian", "", 0x0062, "fy", "FYN", "fy", "fry", "fry"},
+	{"Frisian", "Netherlands", 0x0462, "fy-NL", "FYN", "fy", "fry", "fry"},
+	{"Friulian", "", 0x1000, "fur", "ZZZ", "fur", "fur", "fur"},
+	{"Friulian", "Italy", 0x1000, "fur-IT", "ZZZ", "fur", "fur", "fur"},
+	{"Fulah", "", 0x0067, "ff", "FUL", "ff", "ful", "ful"},
+	{"Fulah (Latin)", "", 0x7C67, "ff-Latn", "FUL", "ff", "ful", "ful"},
+	{"Fulah (Latin)", "Burkina Faso", 0x1000, "ff-Latn-BF", "ZZZ", "ff", "ful", "ful"},
+	{"Fulah", "Cameroon", 0x1000, "ff-CM", "ZZZ", "ff", "ful", "ful"},
+	{"Fulah (Latin)", "Cameroon", 0x1000, "ff-Latn-CM", "ZZZ", "ff", "ful", "ful"},
+	{"Fulah (Latin)", "Gambia", 0x1000, "ff-Latn-GM", "ZZZ", "ff", "ful", "ful"},
+	{"Fulah (Latin)", "Ghana", 0x1000, "ff-Latn-GH", "ZZZ", "ff", "ful", "ful"},
+	{"Fulah", "Guinea", 0x1000, "ff-GN", "ZZZ", "ff", "ful", "ful"},
+	{"Fulah (Latin)", "Guinea", 0x1000, "ff-Latn-GN", "ZZZ", "ff", "ful", "ful"},
+	{"Fulah (Latin)", "Guinea-Bissau", 0x1000, "ff-Latn-GW", "ZZZ", "ff", "ful", "ful"},
+	{"Fulah (Latin)", "Liberia", 0x1000, "ff-Latn-LR", "ZZZ", "ff", "ful", "ful"},
+	{"Fulah", "Mauritania", 0x1000, "ff-MR", "ZZZ", "ff", "ful", "ful"},
+	{"Fulah (Latin)", "Mauritania", 0x1000, "ff-Latn-MR", "ZZZ", "ff", "ful", "ful"},
+	{"Fulah (Latin)", "Niger", 0x1000, "ff-Latn-NE", "ZZZ", "ff", "ful", "ful"},
+	{"Fulah", "Nigeria", 0x0467, "ff-NG", "ZZZ", "ff", "ful", "ful"},
+	{"Fulah (Latin)", "Nigeria", 0x0467, "ff-Latn-NG", "ZZZ", "ff", "ful", "ful"},
+	{"Fulah", "Senegal", 0x0867, "ff-Latn-SN", "FUL", "ff", "ful", "ful"},
+	{"Fulah (Latin)", "Sierra Leone", 0x1000, "ff-Latn-SL", "ZZZ", "ff", "ful", "ful"},
+	{"Galician", "", 0x0056, "gl", "GLC", "gl", "glg", "glg"},
+	{"Galician", "Spain", 0x0456, "gl-ES", "GLC", "gl", "glg", "glg"},
+	{"Ganda", "", 0x1000, "lg", "ZZZ", "lg", "lug", "lug"},
+	{"Ganda", "Uganda", 0x1000, "lg-UG", "ZZZ", "lg", "lug", "lug"},
+	{"Georgian", "", 0x0037, "ka", "KAT", "ka", "kat", "kat"},
+	{"Georgian", "Georgia", 0x0437, "ka-GE", "KAT", "ka", "kat", "kat"},
+	{"German", "", 0x0007, "de", "DEU", "de", "deu", "deu"},
+	{"German", "Austria", 0x0C07, "de-AT", "DEA", "de", "deu", "deu"},
+	{"German", "Belgium", 0x1000, "de-BE", "ZZZ", "de", "deu", "deu"},
+	{"German", "Germany", 0x0407, "de-DE", "DEU", "de", "deu", "deu"},
+	{"German", "Italy", 0x1000, "de-IT", "ZZZ", "de", "deu", "deu"},
+	{"German", "Liechtenstein", 0x1407, "de-LI", "DEC", "de", "deu", "deu"},
+	{"German", "Luxembourg", 0x1007, "de-LU", "DEL", "de", "deu", "deu"},
+	{"German", "Switzerland", 0x0807, "de-CH", "DES", "de", "deu", "deu"},
+	{"Greek", "", 0x0008, "el", "ELL", "el", "ell", "ell"},
+	{"Greek", "Cyprus", 0x1000, "el-CY", "ZZZ", "el", "ell", "ell"},
+	{"Greek", "Greece", 0x0408, "el-GR", "ELL", "el", "ell", "ell"},
+	{"Greenlandic", "", 0x006F, "kl", "KAL", "kl", "kal", "kal"},
+	{"Greenlandic", "Greenland", 0x046F, "kl-GL", "KAL", "kl", "kal", "kal"},
+	{"Guarani", "", 0x0074, "gn", "GRN", "gn", "grn", "grn"},
+	{"Guarani", "Paraguay", 0x0474, "gn-PY", "GRN", "gn", "grn", "grn"},
+	{"Gujarati", "", 0x0047, "gu", "GUJ", "gu", "guj", "guj"},
+	{"Gujarati", "India", 0x0447, "gu-IN", "GUJ", "gu", "guj", "guj"},
+	{"Gusii", "", 0x1000, "guz", "ZZZ", "guz", "guz", "guz"},
+	{"Gusii", "Kenya", 0x1000, "guz-KE", "ZZZ", "guz", "guz", "guz"},
+	{"Haryanvi", "", 0x1000, "bgc", "ZZZ", "bgc", "bgc", "bgc"},
+	{"Haryanvi (Devanagari)", "", 0x1000, "bgc-Deva", "ZZZ", "bgc", "bgc", "bgc"},
+	{"Haryanvi (Devanagari)", "India", 0x1000, "bgc-Deva-IN", "ZZZ", "bgc", "bgc", "bgc"},
+	{"Hausa (Latin)", "", 0x0068, "ha", "HAU", "ha", "hau", "hau"},
+	{"Hausa (Latin)", "", 0x7C68, "ha-Latn", "HAU", "ha", "hau", "hau"},
+	{"Hausa (Latin)", "Ghana", 0x1000, "ha-Latn-GH", "ZZZ", "ha", "hau", "hau"},
+	{"Hausa (Latin)", "Niger", 0x1000, "ha-Latn-NE", "ZZZ", "ha", "hau", "hau"},
+	{"Hausa (Latin)", "Nigeria", 0x0468, "ha-Latn-NG", "HAU", "ha", "hau", "hau"},
+	{"Hawaiian", "", 0x0075, "haw", "HAW", "haw", "haw", "haw"},
+	{"Hawaiian", "United States", 0x0475, "haw-US", "HAW", "haw", "haw", "haw"},
+	{"Hebrew", "", 0x000D, "he", "HEB", "he", "heb", "heb"},
+	{"Hebrew", "Israel", 0x040D, "he-IL", "HEB", "he", "heb", "heb"},
+	{"Hindi", "", 0x0039, "hi", "HIN", "hi", "hin", "hin"},
+	{"Hindi", "India", 0x0439, "hi-IN", "HIN", "hi", "hin", "hin"},
+	{"Hindi (Latin)", "", 0x1000, "hi-Latn", "ZZZ", "hi", "hin", "hin"},
+	{"Hindi (Latin)", "India", 0x1000, "hi-Latn-IN", "ZZZ", "hi", "hin", "hin"},
+	{"Hungarian", "", 0x000E, "hu", "HUN", "hu", "hun", "hun"},
+	{"Hungarian", "Hungary", 0x040E, "hu-HU", "HUN", "hu", "hun", "hun"},
+	{"Icelandic", "", 0x000F, "is", "ISL", "is", "isl", "isl"},
+	{"Icelandic", "Iceland", 0x040F, "is-IS", "ISL", "is", "isl", "isl"},
+	{"Igbo", "", 0x0070, "ig", "IBO", "ig", "ibo", "ibo"},
+	{"Igbo", "Nigeria", 0x0470, "ig-NG", "IBO", "ig", "ibo", "ibo"},
+	{"Indonesian", "", 0x0021, "id", "IND", "id", "ind", "ind"},
+	{"Indonesian", "Indonesia", 0x0421, "id-ID", "IND", "id", "ind", "ind"},
+	{"Interlingua", "", 0x1000, "ia", "ZZZ", "ia", "ina", "ina"},
+	{"Interlingua", "France", 0x1000, "ia-FR", "ZZZ", "ia", "ina", "ina"},
+	{"Interlingua", "World", 0x1000, "ia-001", "ZZZ", "ia", "ina", "ina"},
+	{"Inuktitut (Latin)", "", 0x005D, "iu", "IUK", "iu", "iku", "iku"},
+	{"Inuktitut (Latin)", "", 0x7C5D, "iu-Latn", "IUK", "iu", "iku", "iku"},
+	{"Inuktitut (Latin)", "Canada", 0x085D, "iu-Latn-CA", "IUK", "iu", "iku", "iku"},
+	{"Inuktitut (Syllabics)", "", 0x785D, "iu-Cans", "IUS", "iu", "iku", "iku"},
+	{"Inuktitut (Syllabics)", "Canada", 0x045D, "iu-Cans-CA", "IUS", "iu", "iku", "iku"},
+	{"Irish", "", 0x003C, "ga", "IRE", "ga", "gle", "gle"},
+	{"Irish", "Ireland", 0x083C, "ga-IE", "IRE", "ga", "gle", "gle"},
+	{"Italian", "", 0x0010, "it", "ITA", "it", "ita", "ita"},
+	{"Italian", "Italy", 0x0410, "it-IT", "ITA", "it", "ita", "ita"},
+	{"Italian", "San Marino", 0x1000, "it-SM", "ZZZ", "it", "ita", "ita"},
+	{"Italian", "Switzerland", 0x0810, "it-CH", "ITS", "it", "ita", "ita"},
+	{"Italian", "Vatican City", 0x1000, "it-VA", "ZZZ", "it", "ita", "ita"},
+	{"Japanese", "", 0x0011, "ja", "JPN", "ja", "jpn", "jpn"},
+	{"Japanese", "Japan", 0x0411, "ja-JP", "JPN", "ja", "jpn", "jpn"},
+	{"Javanese", "", 0x1000, "jv", "JAV", "jv", "jav", "jav"},
+	{"Javanese", "Latin", 0x1000, "jv-Latn", "JAV", "jv", "jav", "jav"},
+	{"Javanese", "Latin, Indonesia", 0x1000, "jv-Latn-ID", "JAV", "jv", "jav", "jav"},
+	{"Jola-Fonyi", "", 0x1000, "dyo", "ZZZ", "dyo", "dyo", "dyo"},
+	{"Jola-Fonyi", "Senegal", 0x1000, "dyo-SN", "ZZZ", "dyo", "dyo", "dyo"},
+	{"Kabuverdianu", "", 0x1000, "kea", "ZZZ", "kea", "kea", "kea"},
+	{"Kabuverdianu", "Cabo Verde", 0x1000, "kea-CV", "ZZZ", "kea", "kea", "kea"},
+	{"Kabyle", "", 0x1000, "kab", "ZZZ", "kab", "kab", "kab"},
+	{"Kabyle", "Algeria", 0x1000, "kab-DZ", "ZZZ", "kab", "kab", "kab"},
+	{"Kaingang", "", 0x1000, "kgp", "ZZZ", "kgp", "kgp", "kgp"},
+	{"Kaingang (Latin)", "", 0x1000, "kgp-Latn", "ZZZ", "kgp", "kgp", "kgp"},
+	{"Kaingang (Latin)", "Brazil", 0x1000, "kgp-Latn-BR", "ZZZ", "kgp", "kgp", "kgp"},
+	{"Kako", "", 0x1000, "kkj", "ZZZ", "kkj", "kkj", "kkj"},
+	{"Kako", "Cameroon", 0x1000, "kkj-CM", "ZZZ", "kkj", "kkj", "kkj"},
+	{"Kalenjin", "", 0x1000, "kln", "ZZZ", "kln", "kln", "kln"},
+	{"Kalenjin", "Kenya", 0x1000, "kln-KE", "ZZZ", "kln", "kln", "kln"},
+	{"Kamba", "", 0x1000, "kam", "ZZZ", "kam", "kam", "kam"},
+	{"Kamba", "Kenya", 0x1000, "kam-KE", "ZZZ", "kam", "kam", "kam"},
+	{"Kannada", "", 0x004B, "kn", "KDI", "kn", "kan", "kan"},
+	{"Kannada", "India", 0x044B, "kn-IN", "KDI", "kn", "kan", "kan"},
+	{"Kanuri (Latin)", "Nigeria", 0x0471, "kr-Latn-NG", "ZZZ", "kr", "kau", "kau"},
+	{"Kashmiri", "", 0x0060, "ks", "ZZZ", "ks", "kas", "kas"},
+	{"Kashmiri", "Perso-Arabic", 0x0460, "ks-Arab", "ZZZ", "ks", "kas", "kas"},
+	{"Kashmiri", "Perso-Arabic", 0x1000, "ks-Arab-IN", "ZZZ", "ks", "kas", "kas"},
+	{"Kashmiri (Devanagari)", "India", 0x0860, "ks-Deva-IN", "ZZZ", "ks", "kas", "kas"},
+	{"Kazakh", "", 0x003F, "kk", "KKZ", "kk", "kaz", "kaz"},
+	{"Kazakh", "Kazakhstan", 0x043F, "kk-KZ", "KKZ", "kk", "kaz", "kaz"},
+	{"Khmer", "", 0x0053, "km", "KHM", "km", "khm", "khm"},
+	{"Khmer", "Cambodia", 0x0453, "km-KH", "KHM", "km", "khm", "khm"},
+	{"K'iche", "", 0x0086, "quc", "QUC", "quc", "quc", "quc"},
+	{"K'iche", "Guatemala", 0x0486, "quc-Latn-GT", "QUT", "quc", "quc", "quc"},
+	{"Kikuyu", "", 0x1000, "ki", "ZZZ", "ki", "kik", "kik"},
+	{"Kikuyu", "Kenya", 0x1000, "ki-KE", "ZZZ", "ki", "kik", "kik"},
+	{"Kinyarwanda", "", 0x0087, "rw", "KIN", "rw", "kin", "kin"},
+	{"Kinyarwanda", "Rwanda", 0x0487, "rw-RW", "KIN", "rw", "kin", "kin"},
+	{"Kiswahili", "", 0x0041, "sw", "SWK", "sw", "swa", "swa"},
+	{"Kiswahili", "Kenya", 0x0441, "sw-KE", "SWK", "sw", "swa", "swa"},
+	{"Kiswahili", "Tanzania", 0x1000, "sw-TZ", "ZZZ", "sw", "swa", "swa"},
+	{"Kiswahili", "Uganda", 0x1000, "sw-UG", "ZZZ", "sw", "swa", "swa"},
+	{"Konkani", "", 0x0057, "kok", "KNK", "kok", "kok", "kok"},
+	{"Konkani", "India", 0x0457, "kok-IN", "KNK", "kok", "kok", "kok"},
+	{"Korean", "", 0x0012, "ko", "KOR", "ko", "kor", "kor"},
+	{"Korean", "Korea", 0x0412, "ko-KR", "KOR", "ko", "kor", "kor"},
+	{"Korean", "North Korea", 0x1000, "ko-KP", "ZZZ", "ko", "kor", "kor"},
+	{"Koyra Chiini", "", 0x1000, "khq", "ZZZ", "khq", "khq", "khq"},
+	{"Koyra Chiini", "Mali", 0x1000, "khq-ML", "ZZZ", "khq", "khq", "khq"},
+	{"Koyraboro Senni", "", 0x1000, "ses", "ZZZ", "ses", "ses", "ses"},
+	{"Koyraboro Senni", "Mali", 0x1000, "ses-ML", "ZZZ", "ses", "ses", "ses"},
+	{"Kwasio", "", 0x1000, "nmg", "ZZZ", "nmg", "nmg", "nmg"},
+	{"Kwasio", "Cameroon", 0x1000, "nmg-CM", "ZZZ", "nmg", "nmg", "nmg"},
+	{"Kyrgyz", "", 0x0040, "ky", "KYR", "ky", "kir", "kir"},
+	{"Kyrgyz", "Kyrgyzstan", 0x0440, "ky-KG", "KYR", "ky", "kir", "kir"},
+	{"Kurdish", "Perso-Arabic, Iran", 0x1000, "ku-Arab-IR", "ZZZ", "ku", "kur", "kur"},
+	{"Lakota", "", 0x1000, "lkt", "ZZZ", "lkt", "lkt", "lkt"},
+	{"Lakota", "United States", 0x1000, "lkt-US", "ZZZ", "lkt", "lkt", "lkt"},
+	{"Langi", "", 0x1000, "lag", "ZZZ", "lag", "lag", "lag"},
+	{"Langi", "Tanzania", 0x1000, "lag-TZ", "ZZZ", "lag", "lag", "lag"},
+	{"Lao", "", 0x0054, "lo", "LAO", "lo", "lao", "lao"},
+	{"Lao", "Lao P.D.R.", 0x0454, "lo-LA", "LAO", "lo", "lao", "lao"},
+	{"Latin", "Vatican City", 0x0476, "la-VA", "ZZZ", "la", "lat", "lat"},
+	{"Latvian", "", 0x0026, "lv", "LVI", "lv", "lav", "lav"},
+	{"Latvian", "Latvia", 0x0426, "lv-LV", "LVI", "lv", "lav", "lav"},
+	{"Lingala", "", 0x1000, "ln", "ZZZ", "ln", "lin", "lin"},
+	{"Lingala", "Angola", 0x1000, "ln-AO", "ZZZ", "ln", "lin", "lin"},
+	{"Lingala", "Central African Republic", 0x1000, "ln-CF", "ZZZ", "ln", "lin", "lin"},
+	{"Lingala", "Congo", 0x1000, "ln-CG", "ZZZ", "ln", "lin", "lin"},
+	{"Lingala", "Congo DRC", 0x1000, "ln-CD", "ZZZ", "ln", "lin", "lin"},
+	{"Lithuanian", "", 0x0027, "lt", "LTH", "lt", "lit", "lit"},
+	{"Lithuanian", "Lithuania", 0x0427, "lt-LT", "LTH", "lt", "lit", "lit"},
+	{"Low German", "", 0x1000, "nds", "ZZZ", "nds", "nds", "nds"},
+	{"Low German", "Germany", 0x1000, "nds-DE", "ZZZ", "nds", "nds", "nds"},
+	{"Low German", "Netherlands", 0x1000, "nds-NL", "ZZZ", "nds", "nds", "nds"},
+	{"Lower Sorbian", "", 0x7C2E, "dsb", "DSB", "dsb", "dsb", "dsb"},
+	{"Lower Sorbian", "Germany", 0x082E, "dsb-DE", "DSB", "dsb", "dsb", "dsb"},
+	{"Luba-Katanga", "", 0x1000, "lu", "ZZZ", "lu", "lub", "lub"},
+	{"Luba-Katanga", "Congo DRC", 0x1000, "lu-CD", "ZZZ", "lu", "lub", "lub"},
+	{"Luo", "", 0x1000, "luo", "ZZZ", "luo", "luo", "luo"},
+	{"Luo", "Kenya", 0x1000, "luo-KE", "ZZZ", "luo", "luo", "luo"},
+	{"Luxembourgish", "", 0x006E, "lb", "LBX", "lb", "ltz", "ltz"},
+	{"Luxembourgish", "Luxembourg", 0x046E, "lb-LU", "LBX", "lb", "ltz", "ltz"},
+	{"Luyia", "", 0x1000, "luy", "ZZZ", "luy", "luy", "luy"},
+	{"Luyia", "Kenya", 0x1000, "luy-KE", "ZZZ", "luy", "luy", "luy"},
+	{"Macedonian", "", 0x002F, "mk", "MKI", "mk", "mkd", "mkd"},
+	{"Macedonian", "North Macedonia", 0x042F, "mk-MK", "MKI", "mk", "mkd", "mkd"},
+	{"Machame", "", 0x1000, "jmc", "ZZZ", "jmc", "jmc", "jmc"},
+	{"Machame", "Tanzania", 0x1000, "jmc-TZ", "ZZZ", "jmc", "jmc", "jmc"},
+	{"Makhuwa-Meetto", "", 0x1000, "mgh", "ZZZ", "mgh", "mgh", "mgh"},
+	{"Makhuwa-Meetto", "Mozambique", 0x1000, "mgh-MZ", "ZZZ", "mgh", "mgh", "mgh"},
+	{"Makonde", "", 0x1000, "kde", "ZZZ", "kde", "kde", "kde"},
+	{"Makonde", "Tanzania", 0x1000, "kde-TZ", "ZZZ", "kde", "kde", "kde"},
+	{"Malagasy", "", 0x1000, "mg", "MLG", "mg", "mlg", "mlg"},
+	{"Malagasy", "Madagascar", 0x1000, "mg-MG", "MLG", "mg", "mlg", "mlg"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "msa"},
+	{"Malay", "Brunei Darussalam", 0x083E, "ms-BN", "MSB", "ms", "msa", "msa"},
+	{"Malay", "Malaysia", 0x043E, "ms-MY", "MSL", "ms", "msa", "msa"},
+	{"Malayalam", "", 0x004C, "ml", "MYM", "ml", "mal", "mal"},
+	{"Malayalam", "India", 0x044C, "ml-IN", "MYM", "ml", "mal", "mal"},
+	{"Maltese", "", 0x003A, "mt", "MLT", "mt", "mlt", "mlt"},
+	{"Maltese", "Malta", 0x043A, "mt-MT", "MLT", "mt", "mlt", "mlt"},
+	{"Manx", "", 0x1000, "gv", "ZZZ", "gv", "glv", "glv"},
+	{"Manx", "Isle of Man", 0x1000, "gv-IM", "ZZZ", "gv", "glv", "glv"},
+	{"Maori", "", 0x0081, "mi", "MRI", "mi", "mri", "mri"},
+	{"Maori", "New Zealand", 0x0481, "mi-NZ", "MRI", "mi", "mri", "mri"},
+	{"Mapudungun", "", 0x007A, "arn", "MPD", "arn", "arn", "arn"},
+	{"Mapudungun", "Chile", 0x047A, "arn-CL", "MPD", "arn", "arn", "arn"},
+	{"Marathi", "", 0x004E, "mr", "MAR", "mr", "mar", "mar"},
+	{"Marathi", "India", 0x044E, "mr-IN", "MAR", "mr", "mar", "mar"},
+	{"Masai", "", 0x1000, "mas", "ZZZ", "mas", "mas", "mas"},
+	{"Masai", "Kenya", 0x1000, "mas-KE", "ZZZ", "mas", "mas", "mas"},
+	{"Masai", "Tanzania", 0x1000, "mas-TZ", "ZZZ", "mas", "mas", "mas"},
+	{"Mazanderani", "Iran", 0x1000, "mzn-IR", "ZZZ", "mzn", "mzn", "mzn"},
+	{"Meru", "", 0x1000, "mer", "ZZZ", "mer", "mer", "mer"},
+	{"Meru", "Kenya", 0x1000, "mer-KE", "ZZZ", "mer", "mer", "mer"},
+	{"Meta'", "", 0x1000, "mgo", "ZZZ", "mgo", "mgo", "mgo"},
+	{"Meta'", "Cameroon", 0x1000, "mgo-CM", "ZZZ", "mgo", "mgo", "mgo"},
+	{"Mohawk", "", 0x007C, "moh", "MWK", "moh", "moh", "moh"},
+	{"Mohawk", "Canada", 0x047C, "moh-CA", "MWK", "moh", "moh", "moh"},
+	{"Mongolian (Cyrillic)", "", 0x0050, "mn", "MON", "mn", "mon", "mon"},
+	{"Mongolian (Cyrillic)", "", 0x7850, "mn-Cyrl", "MNN", "mn", "mon", "mon"},
+	{"Mongolian (Cyrillic)", "Mongolia", 0x0450, "mn-MN", "MNN", "mn", "mon", "mon"},
+	{"Mongolian (Traditional Mongolian)", "", 0x7C50, "mn-Mong", "MNG", "mn", "mon", "mon"},
+	{"Mongolian (Traditional Mongolian)", "People's Republic of China", 0x0850, "mn-Mong-CN", "MNG", "mn", "mon", "mon"},
+	{"Mongolian (Traditional Mongolian)", "Mongolia", 0x0C50, "mn-Mong-MN", "MNM", "mn", "mon", "mon"},
+	{"Morisyen", "", 0x1000, "mfe", "ZZZ", "mfe", "mfe", "mfe"},
+	{"Morisyen", "Mauritius", 0x1000, "mfe-MU", "ZZZ", "mfe", "mfe", "mfe"},
+	{"Mundang", "", 0x1000, "mua", "ZZZ", "mua", "mua", "mua"},
+	{"Mundang", "Cameroon", 0x1000, "mua-CM", "ZZZ", "mua", "mua", "mua"},
+	{"N'ko", "", 0x1000, "nqo", "NQO", "nqo", "nqo", "nqo"},
+	{"N'ko", "Guinea", 0x1000, "nqo-GN", "NQO", "nqo", "nqo", "nqo"},
+	{"Nama", "", 0x1000, "naq", "ZZZ", "naq", "naq", "naq"},
+	{"Nama", "Namibia", 0x1000, "naq-NA", "ZZZ", "naq", "naq", "naq"},
+	{"Nepali", "", 0x0061, "ne", "NEP", "ne", "nep", "nep"},
+	{"Nepali", "India", 0x0861, "ne-IN", "NEI", "ne", "nep", "nep"},
+	{"Nepali", "Nepal", 0x0461, "ne-NP", "NEP", "ne", "nep", "nep"},
+	{"Ngiemboon", "", 0x1000, "nnh", "ZZZ", "nnh", "nnh", "nnh"},
+	{"Ngiemboon", "Cameroon", 0x1000, "nnh-CM", "ZZZ", "nnh", "nnh", "nnh"},
+	{"Ngomba", "", 0x1000, "jgo", "ZZZ", "jgo", "jgo", "jgo"},
+	{"Ngomba", "Cameroon", 0x1000, "jgo-CM", "ZZZ", "jgo", "jgo", "jgo"},
+	{"Nheengatu", "", 0x1000, "yrl", "ZZZ", "yrl", "yrl", "yrl"},
+	{"Nheengatu (Latin)", "", 0x1000, "yrl-Latn", "ZZZ", "yrl", "yrl", "yrl"},
+	{"Nheengatu (Latin)", "Brazil", 0x1000, "yrl-Latn-BR", "ZZZ", "yrl", "yrl", "yrl"},
+	{"Nheengatu (Latin)", "Colombia", 0x1000, "yrl-Latn-CO", "ZZZ", "yrl", "yrl", "yrl"},
+	{"Nheengatu (Latin)", "Venezuela", 0x1000, "yrl-Latn-VE", "ZZZ", "yrl", "yrl", "yrl"},
+	{"Northern Luri", "Iraq", 0x1000, "lrc-IQ", "ZZZ", "lrc", "lrc", "lrc"},
+	{"Northern Luri", "Iran", 0x1000, "lrc-IR", "ZZZ", "lrc", "lrc", "lrc"},
+	{"North Ndebele", "", 0x1000, "nd", "ZZZ", "nd", "nde", "nde"},
+	{"North Ndebele", "Zimbabwe", 0x1000, "nd-ZW", "ZZZ", "nd", "nde", "nde"},
+	{"Norwegian (Bokmal)", "", 0x0014, "no", "NOR", "no", "nor", "nor"},
+	{"Norwegian (Bokmal)", "", 0x7C14, "nb", "NOR", "nb", "nob", "nob"},
+	{"Norwegian (Bokmal)", "Norway", 0x0414, "nb-NO", "NOR", "nb", "nob", "nob"},
+	{"Norwegian (Nynorsk)", "", 0x7814, "nn", "NON", "nn", "nno", "nno"},
+	{"Norwegian (Nynorsk)", "Norway", 0x0814, "nn-NO", "NON", "nn", "nno", "nno"},
+	{"Norwegian Bokmål", "Svalbard and Jan Mayen", 0x1000, "nb-SJ", "ZZZ", "nb", "nob", "nob"},
+	{"Nuer", "", 0x1000, "nus", "ZZZ", "nus", "nus", "nus"},
+	{"Nuer", "Sudan", 0x1000, "nus-SD", "ZZZ", "nus", "nus", "nus"},
+	{"Nuer", "South Sudan", 0x1000, "nus-SS", "ZZZ", "nus", "nus", "nus"},
+	{"Nyankole", "", 0x1000, "nyn", "ZZZ", "nyn", "nyn", "nyn"},
+	{"Nyankole", "Uganda", 0x1000, "nyn-UG", "ZZZ", "nyn", "nyn", "nyn"},
+	{"Occitan", "", 0x0082, "oc", "OCI", "oc", "oci", "oci"},
+	{"Occitan", "France", 0x0482, "oc-FR", "OCI", "oc", "oci", "oci"},
+	{"Occitan", "Spain", 0x1000, "oc-ES", "ZZZ", "oc", "oci", "oci"},
+	{"Odia", "", 0x0048, "or", "ORI", "or", "ori", "ori"},
+	{"Odia", "India", 0x0448, "or-IN", "ORI", "or", "ori", "ori"},
+	{"Oromo", "", 0x0072, "om", "ORM", "om", "orm", "orm"},
+	{"Oromo", "Ethiopia", 0x0472, "om-ET", "ORM", "om", "orm", "orm"},
+	{"Oromo", "Kenya", 0x1000, "om-KE", "ZZZ", "om", "orm", "orm"},
+	{"Ossetian", "", 0x1000, "os", "ZZZ", "os", "oss", "oss"},
+	{"Ossetian", "Cyrillic, Georgia", 0x1000, "os-GE", "ZZZ", "os", "oss", "oss"},
+	{"Ossetian", "Cyrillic, Russia", 0x1000, "os-RU", "ZZZ", "os", "oss", "oss"},
+	{"Pashto", "", 0x0063, "ps", "PAS", "ps", "pus", "pus"},
+	{"Pashto", "Afghanistan", 0x0463, "ps-AF", "PAS", "ps", "pus", "pus"},
+	{"Pashto", "Pakistan", 0x1000, "ps-PK", "ZZZ", "ps", "pus", "pus"},
+	{"Persian", "", 0x0029, "fa", "FAR", "fa", "fas", "fas"},
+	{"Persian", "Afghanistan", 0x1000, "fa-AF", "ZZZ", "fa", "fas", "fas"},
+	{"Persian", "Iran", 0x0429, "fa-IR", "FAR", "fa", "fas", "fas"},
+	{"Polish", "", 0x0015, "pl", "PLK", "pl", "pol", "pol"},
+	{"Polish", "Poland", 0x0415, "pl-PL", "PLK", "pl", "pol", "pol"},
+	{"Portuguese", "", 0x0016, "pt", "PTB", "pt", "por", "por"},
+	{"Portuguese", "Angola", 0x1000, "pt-AO", "PTA", "pt", "por", "por"},
+	{"Portuguese", "Brazil", 0x0416, "pt-BR", "PTB", "pt", "por", "por"},
+	{"Portuguese", "Cabo Verde", 0x1000, "pt-CV", "ZZZ", "pt", "por", "por"},
+	{"Portuguese", "Equatorial Guinea", 0x1000, "pt-GQ", "ZZZ", "pt", "por", "por"},
+	{"Portuguese", "Guinea-Bissau", 0x1000, "pt-GW", "ZZZ", "pt", "por", "por"},
+	{"Portuguese", "Luxembourg", 0x1000, "pt-LU", "ZZZ", "pt", "por", "por"},
+	{"Portuguese", "Macao SAR", 0x1000, "pt-MO", "ZZZ", "pt", "por", "por"},
+	{"Portuguese", "Mozambique", 0x1000, "pt-MZ", "ZZZ", "pt", "por", "por"},
+	{"Portuguese", "Portugal", 0x0816, "pt-PT", "PTG", "pt", "por", "por"},
+	{"Portuguese", "São Tomé and Príncipe", 0x1000, "pt-ST", "ZZZ", "pt", "por", "por"},
+	{"Portuguese", "Switzerland", 0x1000, "pt-CH", "ZZZ", "pt", "por", "por"},
+	{"Portuguese", "Timor-Leste", 0x1000, "pt-TL", "ZZZ", "pt", "por", "por"},
+	{"Prussian", "", 0x1000, "prg-001", "ZZZ", "prg", "prg", "prg"},
+	{"Pseudo Language", "Pseudo locale for east Asian/complex script localization testing", 0x05FE, "qps-ploca", "JPN", "qps", "qps", "qps"},
+	{"Pseudo Language", "Pseudo locale used for localization testing", 0x0501, "qps-ploc", "ENU", "qps", "qps", "qps"},
+	{"Pseudo Language", "Pseudo locale used for localization testing of mirrored locales", 0x09FF, "qps-plocm", "ARA", "qps", "qps", "qps"},
+	{"Punjabi", "", 0x0046, "pa", "PAN", "pa", "pan", "pan"},
+	{"Punjabi", "", 0x7C46, "pa-Arab", "PAP", "pa", "pan", "pan"},
+	{"Punjabi", "India", 0x0446, "pa-IN", "PAN", "pa", "pan", "pan"},
+	{"Punjabi", "Islamic Republic of Pakistan", 0x0846, "pa-Arab-PK", "PAP", "pa", "pan", "pan"},
+	{"Quechua", "", 0x006B, "quz", "QUB", "quz", "quz", "quz"},
+	{"Quechua", "Bolivia", 0x046B, "quz-BO", "QUB", "quz", "quz", "quz"},
+	{"Quechua", "Ecuador", 0x086B, "quz-EC", "QUE", "quz", "quz", "quz"},
+	{"Quechua", "Peru", 0x0C6B, "quz-PE", "QUP", "quz", "quz", "quz"},
+	{"Rajasthani", "", 0x1000, "raj", "ZZZ", "raj", "raj", "raj"},
+	{"Rajasthani (Devanagari)", "", 0x1000, "raj-Deva", "ZZZ", "raj", "raj", "raj"},
+	{"Rajasthani (Devanagari)", "India", 0x1000, "raj-Deva-IN", "ZZZ", "raj", "raj", "raj"},
+	{"Ripuarian", "", 0x1000, "ksh", "ZZZ", "ksh", "ksh", "ksh"},
+	{"Ripuarian", "Germany", 0x1000, "ksh-DE", "ZZZ", "ksh", "ksh", "ksh"},
+	{"Romanian", "", 0x0018, "ro", "ROM", "ro", "ron", "ron"},
+	{"Romanian", "Moldova", 0x0818, "ro-MD", "ROD", "ro", "ron", "ron"},
+	{"Romanian", "Romania", 0x0418, "ro-RO", "ROM", "ro", "ron", "ron"},
+	{"Romansh", "", 0x0017, "rm", "RMC", "rm", "roh", "roh"},
+	{"Romansh", "Switzerland", 0x0417, "rm-CH", "RMC", "rm", "roh", "roh"},
+	{"Rombo", "", 0x1000, "rof", "ZZZ", "rof", "rof", "rof"},
+	{"Rombo", "Tanzania", 0x1000, "rof-TZ", "ZZZ", "rof", "rof", "rof"},
+	{"Rundi", "", 0x1000, "rn", "ZZZ", "rn", "run", "run"},
+	{"Rundi", "Burundi", 0x1000, "rn-BI", "ZZZ", "rn", "run", "run"},
+	{"Russian", "", 0x0019, "ru", "RUS", "ru", "rus", "rus"},
+	{"Russian", "Belarus", 0x1000, "ru-BY", "ZZZ", "ru", "rus", "rus"},
+	{"Russian", "Kazakhstan", 0x1000, "ru-KZ", "ZZZ", "ru", "rus", "rus"},
+	{"Russian", "Kyrgyzstan", 0x1000, "ru-KG", "ZZZ", "ru", "rus", "rus"},
+	{"Russian", "Moldova", 0x0819, "ru-MD", "RUM", "ru", "rus", "rus"},
+	{"Russian", "Russia", 0x0419, "ru-RU", "RUS", "ru", "rus", "rus"},
+	{"Russian", "Ukraine", 0x1000, "ru-UA", "ZZZ", "ru", "rus", "rus"},
+	{"Rwa", "", 0x1000, "rwk", "ZZZ", "rwk", "rwk", "rwk"},
+	{"Rwa", "Tanzania", 0x1000, "rwk-TZ", "ZZZ", "rwk", "rwk", "rwk"},
+	{"Saho", "", 0x1000, "ssy", "ZZZ", "ssy", "ssy", "ssy"},
+	{"Saho", "Eritrea", 0x1000, "ssy-ER", "ZZZ", "ssy", "ssy", "ssy"},
+	{"Sakha", "", 0x0085, "sah", "SAH", "sah", "sah", "sah"},
+	{"Sakha", "Russia", 0x0485, "sah-RU", "SAH", "sah", "sah", "sah"},
+	{"Samburu", "", 0x1000, "saq", "ZZZ", "saq", "saq", "saq"},
+	{"Samburu", "Kenya", 0x1000, "saq-KE", "ZZZ", "saq", "saq", "saq"},
+	{"Sami (Inari)", "", 0x703B, "smn", "SMN", "smn", "smn", "smn"},
+	{"Sami (Inari)", "Finland", 0x243B, "smn-FI", "SMN", "smn", "smn", "smn"},
+	{"Sami (Lule)", "", 0x7C3B, "smj", "SMK", "smj", "smj", "smj"},
+	{"Sami (Lule)", "Norway", 0x103B, "smj-NO", "SMJ", "smj", "smj", "smj"},
+	{"Sami (Lule)", "Sweden", 0x143B, "smj-SE", "SMK", "smj", "smj", "smj"},
+	{"Sami (Northern)", "", 0x003B, "se", "SME", "se", "sme", "sme"},
+	{"Sami (Northern)", "Finland", 0x0C3B, "se-FI", "SMG", "se", "sme", "sme"},
+	{"Sami (Northern)", "Norway", 0x043B, "se-NO", "SME", "se", "sme", "sme"},
+	{"Sami (Northern)", "Sweden", 0x083B, "se-SE", "SMF", "se", "sme", "sme"},
+	{"Sami (Skolt)", "", 0x743B, "sms", "SMS", "sms", "sms", "sms"},
+	{"Sami (Skolt)", "Finland", 0x203B, "sms-FI", "SMS", "sms", "sms", "sms"},
+	{"Sami (Southern)", "", 0x783B, "sma", "SMB", "sma", "sma", "sma"},
+	{"Sami (Southern)", "Norway", 0x183B, "sma-NO", "SMA", "sma", "sma", "sma"},
+	{"Sami (Southern)", "Sweden", 0x1C3B, "sma-SE", "SMB", "sma", "sma", "sma"},
+	{"Sango", "", 0x1000, "sg", "ZZZ", "sg", "sag", "sag"},
+	{"Sango", "Central African Republic", 0x1000, "sg-CF", "ZZZ", "sg", "sag", "sag"},
+	{"Sangu", "", 0x1000, "sbp", "ZZZ", "sbp", "sbp", "sbp"},
+	{"Sangu", "Tanzania", 0x1000, "sbp-TZ", "ZZZ", "sbp", "sbp", "sbp"},
+	{"Sanskrit", "", 0x004F, "sa", "SAN", "sa", "san", "san"},
+	{"Sanskrit", "India", 0x044F, "sa-IN", "SAN", "sa", "san", "san"},
+	{"Sardinian", "", 0x1000, "sc", "ZZZ", "sc", "srd", "srd"},
+	{"Sardinian (Latin)", "", 0x1000, "sc-Latn", "ZZZ", "sc", "srd", "srd"},
+	{"Sardinian (Latin)", "Italy", 0x1000, "sc-Latn-IT", "ZZZ", "sc", "srd", "srd"},
+	{"Scottish Gaelic", "", 0x0091, "gd", "GLA", "gd", "gla", "gla"},
+	{"Scottish Gaelic", "United Kingdom", 0x0491, "gd-GB", "GLA", "gd", "gla", "gla"},
+	{"Sena", "", 0x1000, "seh", "ZZZ", "seh", "seh", "seh"},
+	{"Sena", "Mozambique", 0x1000, "seh-MZ", "ZZZ", "seh", "seh", "seh"},
+	{"Serbian (Cyrillic)", "", 0x6C1A, "sr-Cyrl", "SRO", "sr", "srp", "srp"},
+	{"Serbian (Cyrillic)", "Bosnia and Herzegovina", 0x1C1A, "sr-Cyrl-BA", "SRN", "sr", "srp", "srp"},
+	{"Serbian (Cyrillic)", "Montenegro", 0x301A, "sr-Cyrl-ME", "SRQ", "sr", "srp", "srp"},
+	{"Serbian (Cyrillic)", "Serbia", 0x281A, "sr-Cyrl-RS", "SRO", "sr", "srp", "srp"},
+	{"Serbian (Cyrillic)", "Serbia and Montenegro (Former)", 0x0C1A, "sr-Cyrl-CS", "SRB", "sr", "srp", "srp"},
+	{"Serbian (Latin)", "", 0x701A, "sr-Latn", "SRM", "sr", "srp", "srp"},
+	{"Serbian (Latin)", "", 0x7C1A, "sr", "SRB", "sr", "srp", "srp"},
+	{"Serbian (Latin)", "Bosnia and Herzegovina", 0x181A, "sr-Latn-BA", "SRS", "sr", "srp", "srp"},
+	{"Serbian (Latin)", "Montenegro", 0x2C1A, "sr-Latn-ME", "SRP", "sr", "srp", "srp"},
+	{"Serbian (Latin)", "Serbia", 0x241A, "sr-Latn-RS", "SRM", "sr", "srp", "srp"},
+	{"Serbian (Latin)", "Serbia and Montenegro (Former)", 0x081A, "sr-Latn-CS", "SRL", "sr", "srp", "srp"},
+	{"Sesotho sa Leboa", "", 0x006C, "nso", "NSO", "nso", "nso", "nso"},
+	{"Sesotho sa Leboa", "South Africa", 0x046C, "nso-ZA", "NSO", "nso", "nso", "nso"},
+	{"Setswana", "", 0x0032, "tn", "TSN", "tn", "tsn", "tsn"},
+	{"Setswana", "Botswana", 0x0832, "tn-BW", "TSB", "tn", "tsn", "tsn"},
+	{"Setswana", "South Africa", 0x0432, "tn-ZA", "TSN", "tn", "tsn", "tsn"},
+	{"Shambala", "", 0x1000, "ksb", "ZZZ", "ksb", "ksb", "ksb"},
+	{"Shambala", "Tanzania", 0x1000, "ksb-TZ", "ZZZ", "ksb", "ksb", "ksb"},
+	{"Shona", "", 0x1000, "sn", "SNA", "sn", "sna", "sna"},
+	{"Shona", "Latin", 0x1000, "sn-Latn", "SNA", "sn", "sna", "sna"},
+	{"Shona", "Zimbabwe", 0x1000, "sn-Latn-ZW", "SNA", "sn", "sna", "sna"},
+	{"Sindhi", "", 0x0059, "sd", "SIP", "sd", "snd", "snd"},
+	{"Sindhi", "", 0x7C59, "sd-Arab", "SIP", "sd", "snd", "snd"},
+	{"Sindhi", "Islamic Republic of Pakistan", 0x0859, "sd-Arab-PK", "SIP", "sd", "snd", "snd"},
+	{"Sinhala", "", 0x005B, "si", "SIN", "si", "sin", "sin"},
+	{"Sinhala", "Sri Lanka", 0x045B, "si-LK", "SIN", "si", "sin", "sin"},
+	{"Slovak", "", 0x001B, "sk", "SKY", "sk", "slk", "slk"},
+	{"Slovak", "Slovakia", 0x041B, "sk-SK", "SKY", "sk", "slk", "slk"},
+	{"Slovenian", "", 0x0024, "sl", "SLV", "sl", "slv", "slv"},
+	{"Slovenian", "Slovenia", 0x0424, "sl-SI", "SLV", "sl", "slv", "slv"},
+	{"Soga", "", 0x1000, "xog", "ZZZ", "xog", "xog", "xog"},
+	{"Soga", "Uganda", 0x1000, "xog-UG", "ZZZ", "xog", "xog", "xog"},
+	{"Somali", "", 0x0077, "so", "SOM", "so", "som", "som"},
+	{"Somali", "Djibouti", 0x1000, "so-DJ", "ZZZ", "so", "som", "som"},
+	{"Somali", "Ethiopia", 0x1000, "so-ET", "ZZZ", "so", "som", "som"},
+	{"Somali", "Kenya", 0x1000, "so-KE", "ZZZ", "so", "som", "som"},
+	{"Somali", "Somalia", 0x0477, "so-SO", "SOM", "so", "som", "som"},
+	{"Sotho", "", 0x0030, "st", "SOT", "st", "sot", "sot"},
+	{"Sotho", "South Africa", 0x0430, "st-ZA", "SOT", "st", "sot", "sot"},
+	{"South Ndebele", "", 0x1000, "nr", "ZZZ", "nr", "nbl", "nbl"},
+	{"South Ndebele", "South Africa", 0x1000, "nr-ZA", "ZZZ", "nr", "nbl", "nbl"},
+	{"Southern Sotho", "Lesotho", 0x1000, "st-LS", "ZZZ", "st", "sot", "sot"},
+	{"Spanish", "", 0x000A, "es", "ESP", "es", "spa", "spa"},
+	{"Spanish", "Argentina", 0x2C0A, "es-AR", "ESS", "es", "spa", "spa"},
+	{"Spanish", "Belize", 0x1000, "es-BZ", "ZZZ", "es", "spa", "spa"},
+	{"Spanish", "Bolivarian Republic of Venezuela", 0x200A, "es-VE", "ESV", "es", "spa", "spa"},
+	{"Spanish", "Bolivia", 0x400A, "es-BO", "ESB", "es", "spa", "spa"},
+	{"Spanish", "Brazil", 0x1000, "es-BR", "ZZZ", "es", "spa", "spa"},
+	{"Spanish", "Chile", 0x340A, "es-CL", "ESL", "es", "spa", "spa"},
+	{"Spanish", "Colombia", 0x240A, "es-CO", "ESO", "es", "spa", "spa"},
+	{"Spanish", "Costa Rica", 0x140A, "es-CR", "ESC", "es", "spa", "spa"},
+	{"Spanish", "Cuba", 0x5C0A, "es-CU", "ESK", "es", "spa", "spa"},
+	{"Spanish", "Dominican Republic", 0x1C0A, "es-DO", "ESD", "es", "spa", "spa"},
+	{"Spanish", "Ecuador", 0x300A, "es-EC", "ESF", "es", "spa", "spa"},
+	{"Spanish", "El Salvador", 0x440A, "es-SV", "ESE", "es", "spa", "spa"},
+	{"Spanish", "Equatorial Guinea", 0x1000, "es-GQ", "ZZZ", "es", "spa", "spa"},
+	{"Spanish", "Guatemala", 0x100A, "es-GT", "ESG", "es", "spa", "spa"},
+	{"Spanish", "Honduras", 0x480A, "es-HN", "ESH", "es", "spa", "spa"},
+	{"Spanish", "Latin America", 0x580A, "es-419", "ESJ", "es", "spa", "spa"},
+	{"Spanish", "Mexico", 0x080A, "es-MX", "ESM", "es", "spa", "spa"},
+	{"Spanish", "Nicaragua", 0x4C0A, "es-NI", "ESI", "es", "spa", "spa"},
+	{"Spanish", "Panama", 0x180A, "es-PA", "ESA", "es", "spa", "spa"},
+	{"Spanish", "Paraguay", 0x3C0A, "es-PY", "ESZ", "es", "spa", "spa"},
+	{"Spanish", "Peru", 0x280A, "es-PE", "ESR", "es", "spa", "spa"},
+	{"Spanish", "Philippines", 0x1000, "es-PH", "ZZZ", "es", "spa", "spa"},
+	{"Spanish", "Puerto Rico", 0x500A, "es-PR", "ESU", "es", "spa", "spa"},
+	{"Spanish", "Spain", 0x040A, "es-ES_tradnl", "ESP", "es", "spa", "spa"},
+	{"Spanish", "Spain", 0x0C0A, "es-ES", "ESN", "es", "spa", "spa"},
+	{"Spanish", "United States", 0x540A, "es-US", "EST", "es", "spa", "spa"},
+	{"Spanish", "Uruguay", 0x380A, "es-UY", "ESY", "es", "spa", "spa"},
+	{"Standard Moroccan Tamazight", "", 0x1000, "zgh", "ZHG", "zgh", "zgh", "zgh"},
+	{"Standard Moroccan Tamazight", "Morocco", 0x1000, "zgh-Tfng-MA", "ZHG", "zgh", "zgh", "zgh"},
+	{"Standard Moroccan Tamazight", "Tifinagh", 0x1000, "zgh-Tfng", "ZHG", "zgh", "zgh", "zgh"},
+	{"Swati", "", 0x1000, "ss", "ZZZ", "ss", "ssw", "ssw"},
+	{"Swati", "South Africa", 0x1000, "ss-ZA", "ZZZ", "ss", "ssw", "ssw"},
+	{"Swati", "Swaziland", 0x1000, "ss-SZ", "ZZZ", "ss", "ssw", "ssw"},
+	{"Swedish", "", 0x001D, "sv", "SVE", "sv", "swe", "swe"},
+	{"Swedish", "Åland Islands", 0x1000, "sv-AX", "ZZZ", "sv", "swe", "swe"},
+	{"Swedish", "Finland", 0x081D, "sv-FI", "SVF", "sv", "swe", "swe"},
+	{"Swedish", "Sweden", 0x041D, "sv-SE", "SVE", "sv", "swe", "swe"},
+	{"Syriac", "", 0x005A, "syr", "SYR", "syr", "syr", "syr"},
+	{"Syriac", "Syria", 0x045A, "syr-SY", "SYR", "syr", "syr", "syr"},
+	{"Tachelhit", "", 0x1000, "shi", "ZZZ", "shi", "shi", "shi"},
+	{"Tachelhit", "Tifinagh", 0x1000, "shi-Tfng", "ZZZ", "shi", "shi", "shi"},
+	{"Tachelhit", "Tifinagh, Morocco", 0x1000, "shi-Tfng-MA", "ZZZ", "shi", "shi", "shi"},
+	{"Tachelhit (Latin)", "", 0x1000, "shi-Latn", "ZZZ", "shi", "shi", "shi"},
+	{"Tachelhit (Latin)", "Morocco", 0x1000, "shi-Latn-MA", "ZZZ", "shi", "shi", "shi"},
+	{"Taita", "", 0x1000, "dav", "ZZZ", "dav", "dav", "dav"},
+	{"Taita", "Kenya", 0x1000, "dav-KE", "ZZZ", "dav", "dav", "dav"},
+	{"Tajik (Cyrillic)", "", 0x0028, "tg", "TAJ", "tg", "tgk", "tgk"},
+	{"Tajik (Cyrillic)", "", 0x7C28, "tg-Cyrl", "TAJ", "tg", "tgk", "tgk"},
+	{"Tajik (Cyrillic)", "Tajikistan", 0x0428, "tg-Cyrl-TJ", "TAJ", "tg", "tgk", "tgk"},
+	{"Tamazight (Latin)", "", 0x005F, "tzm", "TZA", "tzm", "tzm", "tzm"},
+	{"Tamazight (Latin)", "", 0x7C5F, "tzm-Latn", "TZA", "tzm", "tzm", "tzm"},
+	{"Tamazight (Latin)", "Algeria", 0x085F, "tzm-Latn-DZ", "TZA", "tzm", "tzm", "tzm"},
+	{"Tamil", "", 0x0049, "ta", "TAI", "ta", "tam", "tam"},
+	{"Tamil", "India", 0x0449, "ta-IN", "TAI", "ta", "tam", "tam"},
+	{"Tamil", "Malaysia", 0x1000, "ta-MY", "ZZZ", "ta", "tam", "tam"},
+	{"Tamil", "Singapore", 0x1000, "ta-SG", "ZZZ", "ta", "tam", "tam"},
+	{"Tamil", "Sri Lanka", 0x0849, "ta-LK", "TAM", "ta", "tam", "tam"},
+	{"Tasawaq", "", 0x1000, "twq", "ZZZ", "twq", "twq", "twq"},
+	{"Tasawaq", "Niger", 0x1000, "twq-NE", "ZZZ", "twq", "twq", "twq"},
+	{"Tatar", "", 0x0044, "tt", "TTT", "tt", "tat", "tat"},
+	{"Tatar", "Russia", 0x0444, "tt-RU", "TTT", "tt", "tat", "tat"},
+	{"Telugu", "", 0x004A, "te", "TEL", "te", "tel", "tel"},
+	{"Telugu", "India", 0x044A, "te-IN", "TEL", "te", "tel", "tel"},
+	{"Teso", "", 0x1000, "teo", "ZZZ", "teo", "teo", "teo"},
+	{"Teso", "Kenya", 0x1000, "teo-KE", "ZZZ", "teo", "teo", "teo"},
+	{"Teso", "Uganda", 0x1000, "teo-UG", "ZZZ", "teo", "teo", "teo"},
+	{"Thai", "", 0x001E, "th", "THA", "th", "tha", "tha"},
+	{"Thai", "Thailand", 0x041E, "th-TH", "THA", "th", "tha", "tha"},
+	{"Tibetan", "", 0x0051, "bo", "BOB", "bo", "bod", "bod"},
+	{"Tibetan", "India", 0x1000, "bo-IN", "ZZZ", "bo", "bod", "bod"},
+	{"Tibetan", "People's Republic of China", 0x0451, "bo-CN", "BOB", "bo", "bod", "bod"},
+	{"Tigre", "", 0x1000, "tig", "ZZZ", "tig", "tig", "tig"},
+	{"Tigre", "Eritrea", 0x1000, "tig-ER", "ZZZ", "tig", "tig", "tig"},
+	{"Tigrinya", "", 0x0073, "ti", "TIR", "ti", "tir", "tir"},
+	{"Tigrinya", "Eritrea", 0x0873, "ti-ER", "TIR", "ti", "tir", "tir"},
+	{"Tigrinya", "Ethiopia", 0x0473, "ti-ET", "TIE", "ti", "tir", "tir"},
+	{"Tongan", "", 0x1000, "to", "ZZZ", "to", "ton", "ton"},
+	{"Tongan", "Tonga", 0x1000, "to-TO", "ZZZ", "to", "ton", "ton"},
+	{"Tsonga", "", 0x0031, "ts", "TSO", "ts", "tso", "tso"},
+	{"Tsonga", "South Africa", 0x0431, "ts-ZA", "TSO", "ts", "tso", "tso"},
+	{"Turkish", "", 0x001F, "tr", "TRK", "tr", "tur", "tur"},
+	{"Turkish", "Cyprus", 0x1000, "tr-CY", "ZZZ", "tr", "tur", "tur"},
+	{"Turkish", "Turkey", 0x041F, "tr-TR", "TRK", "tr", "tur", "tur"},
+	{"Turkmen", "", 0x0042, "tk", "TUK", "tk", "tuk", "tuk"},
+	{"Turkmen", "Turkmenistan", 0x0442, "tk-TM", "TUK", "tk", "tuk", "tuk"},
+	{"Ukrainian", "", 0x0022, "uk", "UKR", "uk", "ukr", "ukr"},
+	{"Ukrainian", "Ukraine", 0x0422, "uk-UA", "UKR", "uk", "ukr", "ukr"},
+	{"Upper Sorbian", "", 0x002E, "hsb", "HSB", "hsb", "hsb", "hsb"},
+	{"Upper Sorbian", "Germany", 0x042E, "hsb-DE", "HSB", "hsb", "hsb", "hsb"},
+	{"Urdu", "", 0x0020, "ur", "URD", "ur", "urd", "urd"},
+	{"Urdu", "India", 0x0820, "ur-IN", "URI", "ur", "urd", "urd"},
+	{"Urdu", "Islamic Republic of Pakistan", 0x0420, "ur-PK", "URD", "ur", "urd", "urd"},
+	{"Uyghur", "", 0x0080, "ug", "UIG", "ug", "uig", "uig"},
+	{"Uyghur", "People's Republic of China", 0x0480, "ug-CN", "UIG", "ug", "uig", "uig"},
+	{"Uzbek", "Perso-Arabic", 0x1000, "uz-Arab", "ZZZ", "uz", "uzb", "uzb"},
+	{"Uzbek", "Perso-Arabic, Afghanistan", 0x1000, "uz-Arab-AF", "ZZZ", "uz", "uzb", "uzb"},
+	{"Uzbek (Cyrillic)", "", 0x7843, "uz-Cyrl", "UZC", "uz", "uzb", "uzb"},
+	{"Uzbek (Cyrillic)", "Uzbekistan", 0x0843, "uz-Cyrl-UZ", "UZC", "uz", "uzb", "uzb"},
+	{"Uzbek (Latin)", "", 0x0043, "uz", "UZB", "uz", "uzb", "uzb"},
+	{"Uzbek (Latin)", "", 0x7C43, "uz-Latn", "UZB", "uz", "uzb", "uzb"},
+	{"Uzbek (Latin)", "Uzbekistan", 0x0443, "uz-Latn-UZ", "UZB", "uz", "uzb", "uzb"},
+	{"Vai", "", 0x1000, "vai", "ZZZ", "vai", "vai", "vai"},
+	{"Vai", "", 0x1000, "vai-Vaii", "ZZZ", "vai", "vai", "vai"},
+	{"Vai", "Liberia", 0x1000, "vai-Vaii-LR", "ZZZ", "vai", "vai", "vai"},
+	{"Vai (Latin)", "Liberia", 0x1000, "vai-Latn-LR", "ZZZ", "vai", "vai", "vai"},
+	{"Vai (Latin)", "", 0x1000, "vai-Latn", "ZZZ", "vai", "vai", "vai"},
+	{"Valencian", "Spain", 0x0803, "ca-ES-valencia", "VAL", "ca", "cat", "cat"},
+	{"Venda", "", 0x0033, "ve", "ZZZ", "ve", "ven", "ven"},
+	{"Venda", "South Africa", 0x0433, "ve-ZA", "ZZZ", "ve", "ven", "ven"},
+	{"Vietnamese", "", 0x002A, "vi", "VIT", "vi", "vie", "vie"},
+	{"Vietnamese", "Vietnam", 0x042A, "vi-VN", "VIT", "vi", "vie", "vie"},
+	{"Volapük", "", 0x1000, "vo", "ZZZ", "vo", "vol", "vol"},
+	{"Volapük", "World", 0x1000, "vo-001", "ZZZ", "vo", "vol", "vol"},
+	{"Vunjo", "", 0x1000, "vun", "ZZZ", "vun", "vun", "vun"},
+	{"Vunjo", "Tanzania", 0x1000, "vun-TZ", "ZZZ", "vun", "vun", "vun"},
+	{"Walser", "", 0x1000, "wae", "ZZZ", "wae", "wae", "wae"},
+	{"Walser", "Switzerland", 0x1000, "wae-CH", "ZZZ", "wae", "wae", "wae"},
+	{"Welsh", "", 0x0052, "cy", "CYM", "cy", "cym", "cym"},
+	{"Welsh", "United Kingdom", 0x0452, "cy-GB", "CYM", "cy", "cym", "cym"},
+	{"Wolaytta", "", 0x1000, "wal", "ZZZ", "wal", "wal", "wal"},
+	{"Wolaytta", "Ethiopia", 0x1000, "wal-ET", "ZZZ", "wal", "wal", "wal"},
+	{"Wolof", "", 0x0088, "wo", "WOL", "wo", "wol", "wol"},
+	{"Wolof", "Senegal", 0x0488, "wo-SN", "WOL", "wo", "wol", "wol"},
+	{"Xhosa", "", 0x0034, "xh", "XHO", "xh", "xho", "xho"},
+	{"Xhosa", "South Africa", 0x0434, "xh-ZA", "XHO", "xh", "xho", "xho"},
+	{"Yangben", "", 0x1000, "yav", "ZZZ", "yav", "yav", "yav"},
+	{"Yangben", "Cameroon", 0x1000, "yav-CM", "ZZZ", "yav", "yav", "yav"},
+	{"Yi", "", 0x0078, "ii", "III", "ii", "iii", "iii"},
+	{"Yi", "People's Republic of China", 0x0478, "ii-CN", "III", "ii", "iii", "iii"},
+	{"Yiddish", "World", 0x043D, "yi-001", "ZZZ", "yi", "yid", "yid"},
+	{"Yoruba", "", 0x006A, "yo", "YOR", "yo", "yor", "yor"},
+	{"Yoruba", "Benin", 0x1000, "yo-BJ", "ZZZ", "yo", "yor", "yor"},
+	{"Yoruba", "Nigeria", 0x046A, "yo-NG", "YOR", "yo", "yor", "yor"},
+	{"Zarma", "", 0x1000, "dje", "ZZZ", "dje", "dje", "dje"},
+	{"Zarma", "Niger", 0x1000, "dje-NE", "ZZZ", "dje", "dje", "dje"},
+	{"Zulu", "", 0x0035, "zu", "ZUL", "zu", "zul", "zul"},
+	{"Zulu", "South Africa", 0x0435, "zu-ZA", "ZUL", "zu", "zul", "zul"},
+	{"Chechen", "", 0x1000, "ce", "ZZZ", "ce", "che", "che"},
+	{"Central Kurdish", "", 0x1000, "ckb", "ZZZ", "ckb", "ckb", "ckb"},
+	{"Dogri", "", 0x1000, "doi", "ZZZ", "doi", "doi", "doi"},
+	{"Northern Luri", "", 0x1000, "lrc", "ZZZ", "lrc", "lrc", "lrc"},
+	{"Maithili", "", 0x1000, "mai", "ZZZ", "mai", "mai", "mai"},
+	{"Manipuri", "", 0x0058, "mni", "ZZZ", "mni", "mni", "mni"},
+	{"Mazanderani", "", 0x1000, "mzn", "ZZZ", "mzn", "mzn", "mzn"},
+	{"Nigerian Pidgin", "", 0x1000, "pcm", "ZZZ", "pcm", "pcm", "pcm"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "que"},
+	{"Santali", "", 0x1000, "sat", "ZZZ", "sat", "sat", "sat"},
+	{"Sundanese", "", 0x1000, "su", "ZZZ", "su", "sun", "sun"},
+	{"Yiddish", "", 0x003D, "yi", "ZZZ", "yi", "yid", "yid"},
+	{"Cantonese", "", 0x1000, "yue", "ZZZ", "yue", "yue", "yue"},
+	{"Akan", "", 0x1000, "ak", "ZZZ", "ak", "aka", "fat"},
+	{"Akan", "", 0x1000, "ak", "ZZZ", "ak", "aka", "twi"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "aao"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "abh"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "abv"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "acm"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "acq"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "acw"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "acx"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "acy"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "adf"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "aeb"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "aec"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "afb"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "ajp"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "apc"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "apd"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "arb"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "arq"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "ars"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "ary"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "arz"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "auz"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "avl"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "ayh"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "ayl"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "ayn"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "ayp"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "bbz"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "pga"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "shu"},
+	{"Arabic", "", 0x0001, "ar", "ARA", "ar", "ara", "ssh"},
+	{"Azerbaijani (Latin)", "", 0x002C, "az", "AZE", "az", "aze", "azb"},
+	{"Azerbaijani (Latin)", "", 0x002C, "az", "AZE", "az", "aze", "azj"},
+	{"Dogri", "", 0x1000, "doi", "ZZZ", "doi", "doi", "dgo"},
+	{"Dogri", "", 0x1000, "doi", "ZZZ", "doi", "doi", "xnr"},
+	{"Estonian", "", 0x0025, "et", "ETI", "et", "est", "ekk"},
+	{"Estonian", "", 0x0025, "et", "ETI", "et", "est", "vro"},
+	{"Persian", "", 0x0029, "fa", "FAR", "fa", "fas", "pes"},
+	{"Fulah", "", 0x0067, "ff", "FUL", "ff", "ful", "ffm"},
+	{"Fulah", "", 0x0067, "ff", "FUL", "ff", "ful", "fub"},
+	{"Fulah", "", 0x0067, "ff", "FUL", "ff", "ful", "fuc"},
+	{"Fulah", "", 0x0067, "ff", "FUL", "ff", "ful", "fue"},
+	{"Fulah", "", 0x0067, "ff", "FUL", "ff", "ful", "fuf"},
+	{"Fulah", "", 0x0067, "ff", "FUL", "ff", "ful", "fuh"},
+	{"Fulah", "", 0x0067, "ff", "FUL", "ff", "ful", "fui"},
+	{"Fulah", "", 0x0067, "ff", "FUL", "ff", "ful", "fuq"},
+	{"Fulah", "", 0x0067, "ff", "FUL", "ff", "ful", "fuv"},
+	{"Guarani", "", 0x0074, "gn", "GRN", "gn", "grn", "gnw"},
+	{"Guarani", "", 0x0074, "gn", "GRN", "gn", "grn", "gug"},
+	{"Guarani", "", 0x0074, "gn", "GRN", "gn", "grn", "gui"},
+	{"Guarani", "", 0x0074, "gn", "GRN", "gn", "grn", "gun"},
+	{"Guarani", "", 0x0074, "gn", "GRN", "gn", "grn", "nhd"},
+	{"Inuktitut (Latin)", "", 0x005D, "iu", "IUK", "iu", "iku", "ike"},
+	{"Inuktitut (Latin)", "", 0x005D, "iu", "IUK", "iu", "iku", "ikt"},
+	{"Kanuri (Latin)", "Nigeria", 0x0471, "kr-Latn-NG", "ZZZ", "kr", "kau", "kby"},
+	{"Kanuri (Latin)", "Nigeria", 0x0471, "kr-Latn-NG", "ZZZ", "kr", "kau", "knc"},
+	{"Kanuri (Latin)", "Nigeria", 0x0471, "kr-Latn-NG", "ZZZ", "kr", "kau", "krt"},
+	{"Kalenjin", "", 0x1000, "kln", "ZZZ", "kln", "kln", "enb"},
+	{"Kalenjin", "", 0x1000, "kln", "ZZZ", "kln", "kln", "eyo"},
+	{"Kalenjin", "", 0x1000, "kln", "ZZZ", "kln", "kln", "niq"},
+	{"Kalenjin", "", 0x1000, "kln", "ZZZ", "kln", "kln", "oki"},
+	{"Kalenjin", "", 0x1000, "kln", "ZZZ", "kln", "kln", "pko"},
+	{"Kalenjin", "", 0x1000, "kln", "ZZZ", "kln", "kln", "sgc"},
+	{"Kalenjin", "", 0x1000, "kln", "ZZZ", "kln", "kln", "spy"},
+	{"Kalenjin", "", 0x1000, "kln", "ZZZ", "kln", "kln", "tec"},
+	{"Kalenjin", "", 0x1000, "kln", "ZZZ", "kln", "kln", "tuy"},
+	{"Konkani", "", 0x0057, "kok", "KNK", "kok", "kok", "gom"},
+	{"Konkani", "", 0x0057, "kok", "KNK", "kok", "kok", "knn"},
+	{"Central Kurdish", "", 0x0092, "ku", "KUR", "ku", "kur", "kmr"},
+	{"Central Kurdish", "", 0x0092, "ku", "KUR", "ku", "kur", "sdh"},
+	{"Latvian", "", 0x0026, "lv", "LVI", "lv", "lav", "ltg"},
+	{"Latvian", "", 0x0026, "lv", "LVI", "lv", "lav", "lvs"},
+	{"Luyia", "", 0x1000, "luy", "ZZZ", "luy", "luy", "bxk"},
+	{"Luyia", "", 0x1000, "luy", "ZZZ", "luy", "luy", "ida"},
+	{"Luyia", "", 0x1000, "luy", "ZZZ", "luy", "luy", "lkb"},
+	{"Luyia", "", 0x1000, "luy", "ZZZ", "luy", "luy", "lko"},
+	{"Luyia", "", 0x1000, "luy", "ZZZ", "luy", "luy", "lks"},
+	{"Luyia", "", 0x1000, "luy", "ZZZ", "luy", "luy", "lri"},
+	{"Luyia", "", 0x1000, "luy", "ZZZ", "luy", "luy", "lrm"},
+	{"Luyia", "", 0x1000, "luy", "ZZZ", "luy", "luy", "lsm"},
+	{"Luyia", "", 0x1000, "luy", "ZZZ", "luy", "luy", "lto"},
+	{"Luyia", "", 0x1000, "luy", "ZZZ", "luy", "luy", "lts"},
+	{"Luyia", "", 0x1000, "luy", "ZZZ", "luy", "luy", "lwg"},
+	{"Luyia", "", 0x1000, "luy", "ZZZ", "luy", "luy", "nle"},
+	{"Luyia", "", 0x1000, "luy", "ZZZ", "luy", "luy", "nyd"},
+	{"Luyia", "", 0x1000, "luy", "ZZZ", "luy", "luy", "rag"},
+	{"Malagasy", "", 0x1000, "mg", "MLG", "mg", "mlg", "bhr"},
+	{"Malagasy", "", 0x1000, "mg", "MLG", "mg", "mlg", "bjq"},
+	{"Malagasy", "", 0x1000, "mg", "MLG", "mg", "mlg", "bmm"},
+	{"Malagasy", "", 0x1000, "mg", "MLG", "mg", "mlg", "bzc"},
+	{"Malagasy", "", 0x1000, "mg", "MLG", "mg", "mlg", "msh"},
+	{"Malagasy", "", 0x1000, "mg", "MLG", "mg", "mlg", "plt"},
+	{"Malagasy", "", 0x1000, "mg", "MLG", "mg", "mlg", "skg"},
+	{"Malagasy", "", 0x1000, "mg", "MLG", "mg", "mlg", "tdx"},
+	{"Malagasy", "", 0x1000, "mg", "MLG", "mg", "mlg", "tkg"},
+	{"Malagasy", "", 0x1000, "mg", "MLG", "mg", "mlg", "txy"},
+	{"Malagasy", "", 0x1000, "mg", "MLG", "mg", "mlg", "xmv"},
+	{"Malagasy", "", 0x1000, "mg", "MLG", "mg", "mlg", "xmw"},
+	{"Mongolian (Cyrillic)", "", 0x0050, "mn", "MON", "mn", "mon", "khk"},
+	{"Mongolian (Cyrillic)", "", 0x0050, "mn", "MON", "mn", "mon", "mvf"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "bjn"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "btj"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "bve"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "bvu"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "coa"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "dup"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "hji"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "jak"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "jax"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "kvb"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "kvr"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "kxd"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "lce"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "lcf"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "liw"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "max"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "meo"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "mfa"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "mfb"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "min"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "mly"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "mqg"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "msi"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "mui"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "orn"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "ors"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "pel"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "pse"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "tmw"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "urk"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "vkk"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "vkt"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "xmm"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "zlm"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "zmi"},
+	{"Malay", "", 0x003E, "ms", "MSL", "ms", "msa", "zsm"},
+	{"Nepali", "", 0x0061, "ne", "NEP", "ne", "nep", "dty"},
+	{"Nepali", "", 0x0061, "ne", "NEP", "ne", "nep", "npi"},
+	{"Odia", "", 0x0048, "or", "ORI", "or", "ori", "ory"},
+	{"Odia", "", 0x0048, "or", "ORI", "or", "ori", "spv"},
+	{"Oromo", "", 0x0072, "om", "ORM", "om", "orm", "gax"},
+	{"Oromo", "", 0x0072, "om", "ORM", "om", "orm", "gaz"},
+	{"Oromo", "", 0x0072, "om", "ORM", "om", "orm", "hae"},
+	{"Oromo", "", 0x0072, "om", "ORM", "om", "orm", "orc"},
+	{"Pashto", "", 0x0063, "ps", "PAS", "ps", "pus", "pbt"},
+	{"Pashto", "", 0x0063, "ps", "PAS", "ps", "pus", "pbu"},
+	{"Pashto", "", 0x0063, "ps", "PAS", "ps", "pus", "pst"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "cqu"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qub"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qud"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "quf"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qug"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "quh"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "quk"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qul"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qup"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qur"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qus"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "quw"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qux"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "quy"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qva"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qvc"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qve"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qvh"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qvi"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qvj"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qvl"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qvm"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qvn"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qvo"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qvp"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qvs"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qvw"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qvz"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qwa"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qwc"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qwh"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qws"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qxa"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qxc"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qxh"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qxl"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qxn"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qxo"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qxp"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qxr"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qxt"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qxu"},
+	{"Quechua", "", 0x1000, "qu", "ZZZ", "qu", "que", "qxw"},
+	{"Rajasthani", "", 0x1000, "raj", "ZZZ", "raj", "raj", "bgq"},
+	{"Rajasthani", "", 0x1000, "raj", "ZZZ", "raj", "raj", "gda"},
+	{"Rajasthani", "", 0x1000, "raj", "ZZZ", "raj", "raj", "gju"},
+	{"Rajasthani", "", 0x1000, "raj", "ZZZ", "raj", "raj", "hoj"},
+	{"Rajasthani", "", 0x1000, "raj", "ZZZ", "raj", "raj", "mup"},
+	{"Rajasthani", "", 0x1000, "raj", "ZZZ", "raj", "raj", "wbr"},
+	{"Sanskrit", "", 0x004F, "sa", "SAN", "sa", "san", "cls"},
+	{"Sanskrit", "", 0x004F, "sa", "SAN", "sa", "san", "vsn"},
+	{"Albanian", "", 0x001C, "sq", "SQI", "sq", "sqi", "aae"},
+	{"Albanian", "", 0x001C, "sq", "SQI", "sq", "sqi", "aat"},
+	{"Albanian", "", 0x001C, "sq", "SQI", "sq", "sqi", "aln"},
+	{"Albanian", "", 0x001C, "sq", "SQI", "sq", "sqi", "als"},
+	{"Sardinian", "", 0x1000, "sc", "ZZZ", "sc", "srd", "sdc"},
+	{"Sardinian", "", 0x1000, "sc", "ZZZ", "sc", "srd", "sdn"},
+	{"Sardinian", "", 0x1000, "sc", "ZZZ", "sc", "srd", "src"},
+	{"Sardinian", "", 0x1000, "sc", "ZZZ", "sc", "srd", "sro"},
+	{"Kiswahili", "", 0x0041, "sw", "SWK", "sw", "swa", "swh"},
+	{"Syriac", "", 0x005A, "syr", "SYR", "syr", "syr", "aii"},
+	{"Syriac", "", 0x005A, "syr", "SYR", "syr", "syr", "cld"},
+	{"Uzbek (Latin)", "", 0x0043, "uz", "UZB", "uz", "uzb", "uzn"},
+	{"Uzbek (Latin)", "", 0x0043, "uz", "UZB", "uz", "uzb", "uzs"},
+	{"Yiddish", "", 0x003D, "yi", "ZZZ", "yi", "yid", "ydd"},
+	{"Yiddish", "", 0x003D, "yi", "ZZZ", "yi", "yid", "yih"},
+	{"Chinese (Simplified)", "", 0x7804, "zh", "CHS", "zh", "zho", "cdo"},
+	{"Chinese (Simplified)", "", 0x7804, "zh", "CHS", "zh", "zho", "cjy"},
+	{"Chinese (Simplified)", "", 0x7804, "zh", "CHS", "zh", "zho", "cmn"},
+	{"Chinese (Simplified)", "", 0x7804, "zh", "CHS", "zh", "zho", "cnp"},
+	{"Chinese (Simplified)", "", 0x7804, "zh", "CHS", "zh", "zho", "cpx"},
+	{"Chinese (Simplified)", "", 0x7804, "zh", "CHS", "zh", "zho", "csp"},
+	{"Chinese (Simplified)", "", 0x7804, "zh", "CHS", "zh", "zho", "czh"},
+	{"Chinese (Simplified)", "", 0x7804, "zh", "CHS", "zh", "zho", "czo"},
+	{"Chinese (Simplified)", "", 0x7804, "zh", "CHS", "zh", "zho", "gan"},
+	{"Chinese (Simplified)", "", 0x7804, "zh", "CHS", "zh", "zho", "hak"},
+	{"Chinese (Simplified)", "", 0x7804, "zh", "CHS", "zh", "zho", "hnm"},
+	{"Chinese (Simplified)", "", 0x7804, "zh", "CHS", "zh", "zho", "hsn"},
+	{"Chinese (Simplified)", "", 0x7804, "zh", "CHS", "zh", "zho", "luh"},
+	{"Chinese (Simplified)", "", 0x7804, "zh", "CHS", "zh", "zho", "lzh"},
+	{"Chinese (Simplified)", "", 0x7804, "zh", "CHS", "zh", "zho", "mnp"},
+	{"Chinese (Simplified)", "", 0x7804, "zh", "CHS", "zh", "zho", "nan"},
+	{"Chinese (Simplified)", "", 0x7804, "zh", "CHS", "zh", "zho", "sjc"},
+	{"Chinese (Simplified)", "", 0x7804, "zh", "CHS", "zh", "zho", "wuu"},
+}
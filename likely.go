@@ -0,0 +1,255 @@
+package slang
+
+import "strings"
+
+// likelySubtags is a minimal subset of the CLDR likely subtags data, covering the languages in the database.
+//
+// Keys are "language", "language-Script" or "language-Region" in canonical casing,
+// and values are the most likely "language-Script-Region".
+//
+// See: https://www.unicode.org/reports/tr35/#Likely_Subtags
+var likelySubtags = map[string]string{
+	"af":      "af-Latn-ZA",
+	"am":      "am-Ethi-ET",
+	"ar":      "ar-Arab-EG",
+	"as":      "as-Beng-IN",
+	"az":      "az-Latn-AZ",
+	"az-Arab": "az-Arab-IR",
+	"az-IQ":   "az-Arab-IQ",
+	"az-IR":   "az-Arab-IR",
+	"az-RU":   "az-Cyrl-RU",
+	"be":      "be-Cyrl-BY",
+	"bg":      "bg-Cyrl-BG",
+	"bn":      "bn-Beng-BD",
+	"bo":      "bo-Tibt-CN",
+	"br":      "br-Latn-FR",
+	"bs":      "bs-Latn-BA",
+	"ca":      "ca-Latn-ES",
+	"chr":     "chr-Cher-US",
+	"co":      "co-Latn-FR",
+	"cs":      "cs-Latn-CZ",
+	"cy":      "cy-Latn-GB",
+	"da":      "da-Latn-DK",
+	"de":      "de-Latn-DE",
+	"dv":      "dv-Thaa-MV",
+	"el":      "el-Grek-GR",
+	"en":      "en-Latn-US",
+	"es":      "es-Latn-ES",
+	"et":      "et-Latn-EE",
+	"eu":      "eu-Latn-ES",
+	"fa":      "fa-Arab-IR",
+	"ff":      "ff-Latn-SN",
+	"fi":      "fi-Latn-FI",
+	"fil":     "fil-Latn-PH",
+	"fo":      "fo-Latn-FO",
+	"fr":      "fr-Latn-FR",
+	"fy":      "fy-Latn-NL",
+	"ga":      "ga-Latn-IE",
+	"gd":      "gd-Latn-GB",
+	"gl":      "gl-Latn-ES",
+	"gu":      "gu-Gujr-IN",
+	"ha":      "ha-Latn-NG",
+	"haw":     "haw-Latn-US",
+	"he":      "he-Hebr-IL",
+	"hi":      "hi-Deva-IN",
+	"hr":      "hr-Latn-HR",
+	"hu":      "hu-Latn-HU",
+	"hy":      "hy-Armn-AM",
+	"id":      "id-Latn-ID",
+	"ig":      "ig-Latn-NG",
+	"ii":      "ii-Yiii-CN",
+	"is":      "is-Latn-IS",
+	"it":      "it-Latn-IT",
+	"iu":      "iu-Cans-CA",
+	"ja":      "ja-Jpan-JP",
+	"ka":      "ka-Geor-GE",
+	"kk":      "kk-Cyrl-KZ",
+	"kl":      "kl-Latn-GL",
+	"km":      "km-Khmr-KH",
+	"kn":      "kn-Knda-IN",
+	"ko":      "ko-Kore-KR",
+	"kok":     "kok-Deva-IN",
+	"ks":      "ks-Arab-IN",
+	"ku":      "ku-Latn-TR",
+	"ku-Arab": "ku-Arab-IQ",
+	"ky":      "ky-Cyrl-KG",
+	"lb":      "lb-Latn-LU",
+	"lo":      "lo-Laoo-LA",
+	"lt":      "lt-Latn-LT",
+	"lv":      "lv-Latn-LV",
+	"mi":      "mi-Latn-NZ",
+	"mk":      "mk-Cyrl-MK",
+	"ml":      "ml-Mlym-IN",
+	"mn":      "mn-Cyrl-MN",
+	"mn-CN":   "mn-Mong-CN",
+	"mn-Mong": "mn-Mong-CN",
+	"mr":      "mr-Deva-IN",
+	"ms":      "ms-Latn-MY",
+	"mt":      "mt-Latn-MT",
+	"my":      "my-Mymr-MM",
+	"nb":      "nb-Latn-NO",
+	"ne":      "ne-Deva-NP",
+	"nl":      "nl-Latn-NL",
+	"nn":      "nn-Latn-NO",
+	"no":      "no-Latn-NO",
+	"oc":      "oc-Latn-FR",
+	"om":      "om-Latn-ET",
+	"or":      "or-Orya-IN",
+	"pa":      "pa-Guru-IN",
+	"pa-Arab": "pa-Arab-PK",
+	"pa-PK":   "pa-Arab-PK",
+	"pl":      "pl-Latn-PL",
+	"ps":      "ps-Arab-AF",
+	"pt":      "pt-Latn-BR",
+	"rm":      "rm-Latn-CH",
+	"ro":      "ro-Latn-RO",
+	"ru":      "ru-Cyrl-RU",
+	"rw":      "rw-Latn-RW",
+	"sa":      "sa-Deva-IN",
+	"sd":      "sd-Arab-PK",
+	"sd-Deva": "sd-Deva-IN",
+	"sd-IN":   "sd-Deva-IN",
+	"shi":     "shi-Tfng-MA",
+	"si":      "si-Sinh-LK",
+	"sk":      "sk-Latn-SK",
+	"sl":      "sl-Latn-SI",
+	"so":      "so-Latn-SO",
+	"sq":      "sq-Latn-AL",
+	"sr":      "sr-Cyrl-RS",
+	"sr-ME":   "sr-Latn-ME",
+	"sv":      "sv-Latn-SE",
+	"sw":      "sw-Latn-TZ",
+	"syr":     "syr-Syrc-IQ",
+	"ta":      "ta-Taml-IN",
+	"te":      "te-Telu-IN",
+	"tg":      "tg-Cyrl-TJ",
+	"th":      "th-Thai-TH",
+	"ti":      "ti-Ethi-ET",
+	"tk":      "tk-Latn-TM",
+	"tn":      "tn-Latn-ZA",
+	"tr":      "tr-Latn-TR",
+	"tt":      "tt-Cyrl-RU",
+	"tzm":     "tzm-Latn-MA",
+	"ug":      "ug-Arab-CN",
+	"uk":      "uk-Cyrl-UA",
+	"ur":      "ur-Arab-PK",
+	"uz":      "uz-Latn-UZ",
+	"uz-AF":   "uz-Arab-AF",
+	"uz-Arab": "uz-Arab-AF",
+	"vai":     "vai-Vaii-LR",
+	"vi":      "vi-Latn-VN",
+	"wo":      "wo-Latn-SN",
+	"xh":      "xh-Latn-ZA",
+	"yi":      "yi-Hebr-001",
+	"yo":      "yo-Latn-NG",
+	"zh":      "zh-Hans-CN",
+	"zh-HK":   "zh-Hant-HK",
+	"zh-Hant": "zh-Hant-TW",
+	"zh-MO":   "zh-Hant-MO",
+	"zh-TW":   "zh-Hant-TW",
+	"zu":      "zu-Latn-ZA",
+}
+
+// AddLikelySubtags returns the tag with the missing script and region filled by the most likely ones,
+// following the CLDR likely subtags algorithm.
+//
+// Case insensitive, support both dash (-) and underscore (_) as separator. The result is in canonical casing.
+//
+// Subtags present in the tag are never replaced, and variants and extensions are kept at the end.
+// Only a minimal embedded table covering the languages in the database is used, rather than the full CLDR data.
+// If the language is not in the table, the tag is returned unchanged apart from casing.
+//
+// # Examples
+//  1. "en" will return "en-Latn-US".
+//  2. "zh" will return "zh-Hans-CN", and "zh-TW" will return "zh-Hant-TW".
+//  3. "sr-ME" will return "sr-Latn-ME".
+//  4. "en-GB" will return "en-Latn-GB".
+//  5. "tlh" will return "tlh".
+func AddLikelySubtags(tag string) string {
+	canonical := CanonicalizeBCP47(tag)
+	parts := splitTag(canonical)
+	likely, ok := lookupLikely(parts)
+	if !ok {
+		return canonical
+	}
+
+	rest := strings.Split(canonical, "-")[1:]
+	result := []string{parts.language, ScriptOf(likely), RegionOf(likely)}
+	if parts.script != "" {
+		result[1], rest = rest[0], rest[1:]
+	}
+	if parts.region != "" {
+		result[2], rest = rest[0], rest[1:]
+	}
+	return strings.Join(append(result, rest...), "-")
+}
+
+// lookupLikely returns the most likely "language-Script-Region" of the tag parts from the likely subtags table.
+//
+// Tags with extended language subtags are not in the table.
+func lookupLikely(parts tagParts) (string, bool) {
+	if parts.language == "" || len(parts.extlangs) > 0 {
+		return "", false
+	}
+	keys := []string{}
+	if parts.region != "" {
+		keys = append(keys, CanonicalizeBCP47(parts.language+"-"+parts.region))
+	}
+	if parts.script != "" {
+		keys = append(keys, CanonicalizeBCP47(parts.language+"-"+parts.script))
+	}
+	for _, key := range append(keys, parts.language) {
+		if likely, ok := likelySubtags[key]; ok {
+			return likely, true
+		}
+	}
+	return "", false
+}
+
+// MinimizeSubtags returns the shortest tag which expands to the same tag by AddLikelySubtags,
+// removing the script and region which can be derived from the likely subtags table.
+//
+// Case insensitive, support both dash (-) and underscore (_) as separator. The result is in canonical casing.
+//
+// Variants and extensions are kept at the end.
+// If the language is not in the table, the tag is returned unchanged apart from casing.
+//
+// For tags whose language is in the table, minimizing then maximizing is idempotent:
+// AddLikelySubtags(MinimizeSubtags(tag)) is the same as AddLikelySubtags(tag).
+//
+// # Examples
+//  1. "zh-Hans-CN" will return "zh".
+//  2. "en-Latn-US" will return "en".
+//  3. "zh-Hant-TW" will return "zh-TW".
+//  4. "sr-Latn-RS" will return "sr-Latn".
+func MinimizeSubtags(tag string) string {
+	canonical := CanonicalizeBCP47(tag)
+	if _, ok := lookupLikely(splitTag(canonical)); !ok {
+		return canonical
+	}
+
+	subtags := strings.Split(AddLikelySubtags(canonical), "-")
+	language, script, region, rest := subtags[0], subtags[1], subtags[2], subtags[3:]
+	maximized := strings.Join(subtags[:3], "-")
+	for _, trial := range []string{language, language + "-" + region, language + "-" + script} {
+		if AddLikelySubtags(trial) == maximized {
+			return strings.Join(append([]string{trial}, rest...), "-")
+		}
+	}
+	return strings.Join(subtags, "-")
+}
+
+// DefaultScript returns the ISO 15924 script used by the language when none is specified, in title case.
+//
+// The language is the primary language subtag of BCP47, which is the ISO 639-1 code if the language has one.
+// Case insensitive. It uses the same embedded table as AddLikelySubtags.
+//
+// If the language is unknown, it will return empty string.
+//
+// # Examples
+//  1. "zh" will return "Hans".
+//  2. "en" and "fr" will return "Latn".
+//  3. "ar" will return "Arab", and "ru" will return "Cyrl".
+func DefaultScript(iso639 string) string {
+	return ScriptOf(likelySubtags[toLowerASCII(iso639)])
+}
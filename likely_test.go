@@ -0,0 +1,148 @@
+package slang_test
+
+import (
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestWithLikelySubtags(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	if hasBCP47(lp.FindAllByBCP47("zh-TW"), "zh-Hant") {
+		t.Errorf("Error: FindAllByBCP47(zh-TW) should not return 'zh-Hant' by default")
+	}
+
+	lp.WithLikelySubtags()
+	tests := map[string]string{
+		"zh-CN": "zh-Hans",
+		"zh_tw": "zh-Hant",
+		"zh-HK": "zh-Hant",
+		"zh":    "zh-Hans",
+	}
+	for tag, expected := range tests {
+		langs := lp.FindAllByBCP47(tag)
+		if !hasBCP47(langs, expected) {
+			t.Errorf("Error: FindAllByBCP47(%s) should return '%s'", tag, expected)
+		}
+		if langs[0].BCP47 != slang.CanonicalizeBCP47(tag) {
+			t.Errorf("Error: FindAllByBCP47(%s) should return '%s' first", tag, slang.CanonicalizeBCP47(tag))
+		}
+	}
+	if hasBCP47(lp.FindAllByBCP47("zh-TW"), "zh-Hans") {
+		t.Errorf("Error: FindAllByBCP47(zh-TW) should not return 'zh-Hans'")
+	}
+	if langs := lp.FindAllByBCP47("invalid"); len(langs) != 0 {
+		t.Errorf("Error: FindAllByBCP47(invalid) should be empty")
+	}
+}
+
+func hasBCP47(langs []slang.Lang, bcp47 string) bool {
+	for _, lang := range langs {
+		if lang.BCP47 == bcp47 {
+			return true
+		}
+	}
+	return false
+}
+
+func TestAddLikelySubtags(t *testing.T) {
+	tests := map[string]string{
+		"en":             "en-Latn-US",
+		"EN_us":          "en-Latn-US",
+		"en-GB":          "en-Latn-GB",
+		"zh":             "zh-Hans-CN",
+		"zh-TW":          "zh-Hant-TW",
+		"zh-Hant":        "zh-Hant-TW",
+		"zh-Hant-CN":     "zh-Hant-CN",
+		"az":             "az-Latn-AZ",
+		"az-IR":          "az-Arab-IR",
+		"uz-Cyrl":        "uz-Cyrl-UZ",
+		"sr":             "sr-Cyrl-RS",
+		"sr-Latn":        "sr-Latn-RS",
+		"sr-ME":          "sr-Latn-ME",
+		"de-1901":        "de-Latn-DE-1901",
+		"en-US-x-twain":  "en-Latn-US-x-twain",
+		"tlh":            "tlh",
+		"x-klingon":      "x-klingon",
+		"zh-yue-HK":      "zh-yue-HK",
+		"":               "",
+		"ca-ES-valencia": "ca-Latn-ES-valencia",
+		"pa-Arab":        "pa-Arab-PK",
+		"yi":             "yi-Hebr-001",
+		"iu_latn":        "iu-Latn-CA",
+		"es-419":         "es-Latn-419",
+		"sd-IN":          "sd-Deva-IN",
+		"en-Latn-US":     "en-Latn-US",
+		"fr-CA":          "fr-Latn-CA",
+		"es-ES_tradnl":   "es-Latn-ES-tradnl",
+	}
+	for tag, expected := range tests {
+		if actual := slang.AddLikelySubtags(tag); actual != expected {
+			t.Errorf("Error: AddLikelySubtags(%s) should be '%s', got '%s'", tag, expected, actual)
+		}
+	}
+}
+
+func TestMinimizeSubtags(t *testing.T) {
+	tests := map[string]string{
+		"zh-Hans-CN":         "zh",
+		"zh_hans":            "zh",
+		"zh-CN":              "zh",
+		"zh-Hant-TW":         "zh-TW",
+		"zh-Hant":            "zh-TW",
+		"zh-Hant-HK":         "zh-HK",
+		"en-Latn-US":         "en",
+		"en-US":              "en",
+		"en-GB":              "en-GB",
+		"sr-Cyrl-RS":         "sr",
+		"sr-Latn-RS":         "sr-Latn",
+		"az-Arab-IR":         "az-IR",
+		"de-Latn-DE-1901":    "de-1901",
+		"en-Latn-US-x-twain": "en-x-twain",
+		"tlh":                "tlh",
+		"":                   "",
+	}
+	for tag, expected := range tests {
+		if actual := slang.MinimizeSubtags(tag); actual != expected {
+			t.Errorf("Error: MinimizeSubtags(%s) should be '%s', got '%s'", tag, expected, actual)
+		}
+	}
+}
+
+func TestMinimizeSubtagsIdempotent(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	for _, lang := range lp.Entries() {
+		maximized := slang.AddLikelySubtags(lang.BCP47)
+		if actual := slang.AddLikelySubtags(slang.MinimizeSubtags(lang.BCP47)); actual != maximized {
+			t.Errorf("Error: AddLikelySubtags(MinimizeSubtags(%s)) should be '%s', got '%s'", lang.BCP47, maximized, actual)
+		}
+	}
+}
+
+func TestDefaultScript(t *testing.T) {
+	tests := map[string]string{
+		"zh":  "Hans",
+		"en":  "Latn",
+		"FR":  "Latn",
+		"ar":  "Arab",
+		"ru":  "Cyrl",
+		"sr":  "Cyrl",
+		"ja":  "Jpan",
+		"fil": "Latn",
+		"tlh": "",
+		"":    "",
+	}
+	for iso639, expected := range tests {
+		if actual := slang.DefaultScript(iso639); actual != expected {
+			t.Errorf("Error: DefaultScript(%s) should be '%s', got '%s'", iso639, expected, actual)
+		}
+	}
+}
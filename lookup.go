@@ -0,0 +1,41 @@
+package slang
+
+import "fmt"
+
+// FindByBCP47E is like FindByBCP47, but returns ErrNotFound instead of nil when no value is found.
+func (p *LangParser) FindByBCP47E(bcp47 string) (*Lang, error) {
+	return found(p.FindByBCP47(bcp47), bcp47)
+}
+
+// FindByWinIDE is like FindByWinID, but returns ErrNotFound instead of nil when no value is found.
+func (p *LangParser) FindByWinIDE(winID string) (*Lang, error) {
+	return found(p.FindByWinID(winID), winID)
+}
+
+// FindByISO639Set1E is like FindByISO639Set1, but returns ErrNotFound instead of nil when no value is found.
+func (p *LangParser) FindByISO639Set1E(iso639 string) (*Lang, error) {
+	return found(p.FindByISO639Set1(iso639), iso639)
+}
+
+// FindByISO639Set2E is like FindByISO639Set2, but returns ErrNotFound instead of nil when no value is found.
+func (p *LangParser) FindByISO639Set2E(iso639 string) (*Lang, error) {
+	return found(p.FindByISO639Set2(iso639), iso639)
+}
+
+// FindByISO639Set3E is like FindByISO639Set3, but returns ErrNotFound instead of nil when no value is found.
+func (p *LangParser) FindByISO639Set3E(iso639 string) (*Lang, error) {
+	return found(p.FindByISO639Set3(iso639), iso639)
+}
+
+// FindByISOCodeE is like FindByISOCode, but returns ErrNotFound instead of nil when no value is found.
+func (p *LangParser) FindByISOCodeE(iso639 string) (*Lang, error) {
+	return found(p.FindByISOCode(iso639), iso639)
+}
+
+// found wraps the result of a single lookup, returning ErrNotFound with the value if lang is nil.
+func found(lang *Lang, value string) (*Lang, error) {
+	if lang == nil {
+		return nil, fmt.Errorf("%w: %q", ErrNotFound, value)
+	}
+	return lang, nil
+}
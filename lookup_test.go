@@ -0,0 +1,42 @@
+package slang_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestFindByE(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	finds := map[string]func(string) (*slang.Lang, error){
+		"FindByBCP47E":      lp.FindByBCP47E,
+		"FindByWinIDE":      lp.FindByWinIDE,
+		"FindByISO639Set1E": lp.FindByISO639Set1E,
+		"FindByISO639Set2E": lp.FindByISO639Set2E,
+		"FindByISO639Set3E": lp.FindByISO639Set3E,
+		"FindByISOCodeE":    lp.FindByISOCodeE,
+	}
+	for name, find := range finds {
+		if lang, err := find("invalid"); lang != nil || !errors.Is(err, slang.ErrNotFound) {
+			t.Errorf("Error: %s(invalid) should return ErrNotFound, got %v", name, err)
+		}
+	}
+
+	if lang, err := lp.FindByBCP47E("zh_tw"); err != nil || lang.BCP47 != "zh-TW" {
+		t.Errorf("Error: FindByBCP47E(zh_tw) should be 'zh-TW', got %v", err)
+	}
+	if lang, err := lp.FindByWinIDE("CHS"); err != nil || lang.BCP47 != "zh" {
+		t.Errorf("Error: FindByWinIDE(CHS) should be 'zh', got %v", err)
+	}
+	if lang, err := lp.FindByISOCodeE("wuu"); err != nil || lang.BCP47 != "zh" {
+		t.Errorf("Error: FindByISOCodeE(wuu) should be 'zh', got %v", err)
+	}
+	if lang, err := slang.FindByISO639Set1E("en"); err != nil || lang.ISO639Set1 != "en" {
+		t.Errorf("Error: FindByISO639Set1E(en) should be 'en', got %v", err)
+	}
+}
@@ -0,0 +1,57 @@
+package slang
+
+// macrolanguages is the set of ISO 639-3 codes with macrolanguage scope.
+//
+// See: https://iso639-3.sil.org/about/scope#Macrolanguages
+var macrolanguages = map[string]bool{
+	"aka": true, "ara": true, "aym": true, "aze": true, "bal": true, "bik": true, "bnc": true, "bua": true, "chm": true, "cre": true,
+	"del": true, "den": true, "din": true, "doi": true, "est": true, "fas": true, "ful": true, "gba": true, "gon": true, "grb": true,
+	"grn": true, "hai": true, "hbs": true, "hmn": true, "iku": true, "ipk": true, "jrb": true, "kau": true, "kln": true, "kok": true,
+	"kom": true, "kon": true, "kpe": true, "kur": true, "lah": true, "lav": true, "luy": true, "man": true, "mlg": true, "mon": true,
+	"msa": true, "mwr": true, "nep": true, "nor": true, "oji": true, "ori": true, "orm": true, "pus": true, "que": true, "raj": true,
+	"rom": true, "sqi": true, "srd": true, "swa": true, "syr": true, "tmh": true, "uzb": true, "yid": true, "zap": true, "zha": true,
+	"zho": true, "zza": true,
+}
+
+// IsMacrolanguage checks if the language is a macrolanguage (example: zho for Chinese, ara for Arabic).
+//
+// A macrolanguage entry has the same ISO 639-2 and ISO 639-3 code, which is a registered ISO 639-3 macrolanguage.
+func (lang Lang) IsMacrolanguage() bool {
+	return equalFoldASCII(lang.ISO639Set3, lang.ISO639Set2) && macrolanguages[toLowerASCII(lang.ISO639Set3)]
+}
+
+// IsSublanguage checks if the language is an individual language of a macrolanguage (example: cmn for Mandarin Chinese).
+//
+// A sublanguage entry has an ISO 639-3 code different from its ISO 639-2 code, which is the code of the macrolanguage.
+func (lang Lang) IsSublanguage() bool {
+	return lang.ISO639Set2 != "" && lang.ISO639Set3 != "" && !equalFoldASCII(lang.ISO639Set2, lang.ISO639Set3)
+}
+
+// Macrolanguage returns the macrolanguage of the sublanguage with the given ISO 639-3 code (example: zho for cmn).
+//
+// Case insensitive. If there is multiple possible languages found, it will return the language picked by the
+// BestPolicy of the parser, which is the language with the shortest BCP47 tag by default.
+//
+// If the code is not a sublanguage of any macrolanguage, it will return nil.
+func (p *LangParser) Macrolanguage(iso639set3 string) *Lang {
+	for _, lang := range p.FindAllByISO639Set3(iso639set3) {
+		if lang.IsSublanguage() {
+			return p.FindByISO639Set3(lang.ISO639Set2)
+		}
+	}
+	return nil
+}
+
+// Sublanguages returns all sublanguages of the macrolanguage with the given ISO 639-2 code
+// (example: cmn, wuu, yue... for zho).
+//
+// Case insensitive. Result is sorted by BCP47 tag length.
+//
+// If the code is not a macrolanguage, or it has no sublanguages in the database, it will return an empty slice.
+func (p *LangParser) Sublanguages(macro string) []Lang {
+	results := p.Where(func(lang Lang) bool {
+		return equalFoldASCII(lang.ISO639Set2, macro) && lang.IsSublanguage()
+	})
+	sortByBCP47Tag(results)
+	return uniqueLangs(results)
+}
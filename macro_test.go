@@ -0,0 +1,86 @@
+package slang_test
+
+import (
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestIsMacrolanguage(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	if lang := lp.FindByISO639Set3("zho"); !lang.IsMacrolanguage() || lang.IsSublanguage() {
+		t.Errorf("Error: zho should be a macrolanguage")
+	}
+	if lang := lp.FindByISO639Set3("cmn"); lang.IsMacrolanguage() || !lang.IsSublanguage() {
+		t.Errorf("Error: cmn should be a sublanguage")
+	}
+	if lang := lp.FindByISO639Set3("eng"); lang.IsMacrolanguage() || lang.IsSublanguage() {
+		t.Errorf("Error: eng should be neither a macrolanguage nor a sublanguage")
+	}
+	if lang := (slang.Lang{ISO639Set2: "ZHO", ISO639Set3: "zho"}); !lang.IsMacrolanguage() || lang.IsSublanguage() {
+		t.Errorf("Error: ZHO/zho should be a macrolanguage")
+	}
+}
+
+func TestMacrolanguage(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	for code, expected := range map[string]string{
+		"cmn": "zho",
+		"WUU": "zho",
+		"arz": "ara",
+		"pes": "fas",
+		"azj": "aze",
+	} {
+		lang := lp.Macrolanguage(code)
+		if lang == nil || lang.ISO639Set3 != expected || !lang.IsMacrolanguage() {
+			t.Errorf("Error: Macrolanguage(%s) should be '%s', got %v", code, expected, lang)
+		}
+	}
+
+	for _, code := range []string{"eng", "zho", "invalid"} {
+		if lang := lp.Macrolanguage(code); lang != nil {
+			t.Errorf("Error: Macrolanguage(%s) should be nil, got %v", code, lang)
+		}
+	}
+}
+
+func TestSublanguages(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	langs := lp.Sublanguages("ZHO")
+	found := map[string]bool{}
+	for i, lang := range langs {
+		if lang.ISO639Set2 != "zho" || lang.ISO639Set3 == "zho" {
+			t.Errorf("Error: Sublanguages(zho) should not contain %v", lang)
+		}
+		if i > 0 && len(lang.BCP47) < len(langs[i-1].BCP47) {
+			t.Errorf("Error: Sublanguages(zho) should be sorted by BCP47 tag length")
+		}
+		found[lang.ISO639Set3] = true
+	}
+	for _, code := range []string{"cmn", "wuu", "hak", "nan"} {
+		if !found[code] {
+			t.Errorf("Error: Sublanguages(zho) should contain '%s'", code)
+		}
+	}
+
+	if langs := lp.Sublanguages("ara"); len(langs) < 10 {
+		t.Errorf("Error: Sublanguages(ara) should have many languages, got %d", len(langs))
+	}
+	for _, code := range []string{"eng", "cmn", "invalid"} {
+		if langs := lp.Sublanguages(code); len(langs) != 0 {
+			t.Errorf("Error: Sublanguages(%s) should be empty, got %v", code, langs)
+		}
+	}
+}
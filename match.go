@@ -0,0 +1,143 @@
+package slang
+
+import "strconv"
+
+// MatchKind is the field of the language which a value is matched on by ParseWithMatch.
+type MatchKind int
+
+const (
+	// MatchNone means the value is not matched.
+	MatchNone MatchKind = iota
+
+	// MatchBCP47 means the value is matched as a BCP47 tag, see FindByBCP47.
+	MatchBCP47
+
+	// MatchWinID means the value is matched as a Windows language ID, see FindByWinID.
+	MatchWinID
+
+	// MatchISO3 means the value is matched as an ISO 639-3 code, see FindByISO639Set3.
+	MatchISO3
+
+	// MatchISO2 means the value is matched as an ISO 639-2 code, see FindByISO639Set2.
+	MatchISO2
+
+	// MatchISO1 means the value is matched as an ISO 639-1 code, see FindByISO639Set1.
+	MatchISO1
+
+	// MatchLCID means the value is matched as a Microsoft LCID in decimal or hex with 0x prefix (example: 0x0409).
+	//
+	// Parse does not match LCIDs unless it is added by WithParseOrder. Reserved LCIDs never match (see FindByMSLCID).
+	MatchLCID
+)
+
+// defaultParseOrder is the order of the fields tried by Parse.
+var defaultParseOrder = []MatchKind{MatchBCP47, MatchISO3, MatchISO2, MatchISO1, MatchWinID}
+
+// String returns the name of the field matched on (example: "BCP47" or "WinID").
+func (kind MatchKind) String() string {
+	switch kind {
+	case MatchBCP47:
+		return "BCP47"
+	case MatchWinID:
+		return "WinID"
+	case MatchISO3:
+		return "ISO639-3"
+	case MatchISO2:
+		return "ISO639-2"
+	case MatchISO1:
+		return "ISO639-1"
+	case MatchLCID:
+		return "LCID"
+	}
+	return "None"
+}
+
+// WithParseOrder sets the order of the fields tried by Parse, ParseE and ParseWithMatch,
+// such as trying Windows language ID first when most values are Windows language IDs.
+// Fields not in the order are not tried.
+//
+// The default order is MatchBCP47, MatchISO3, MatchISO2, MatchISO1, MatchWinID.
+// Calling it without any field restores the default order.
+func (p *LangParser) WithParseOrder(order ...MatchKind) *LangParser {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.order = append([]MatchKind(nil), order...)
+	p.invalidate()
+	return p
+}
+
+// ParseWithMatch is like Parse, but also returns which field of the language the value is matched on,
+// so callers can know why a language is returned (example: MatchWinID for "CHS").
+//
+// If the language code is not found, it will return nil and MatchNone.
+func (p *LangParser) ParseWithMatch(value string) (*Lang, MatchKind) {
+	p.mu.RLock()
+	order, cache := p.order, p.cache
+	p.mu.RUnlock()
+	if cache == nil {
+		return p.ParseWith(value, order...)
+	}
+
+	lang, kind, gen, ok := cache.get(value)
+	if !ok {
+		lang, kind = p.ParseWith(value, order...)
+		cache.put(value, gen, lang, kind)
+	}
+	return lang, kind
+}
+
+// ParseWith is like ParseWithMatch, but tries the fields in the given order for this call only,
+// regardless of WithParseOrder (example: ParseWith("0x0409", MatchLCID)).
+//
+// If no field is given, it uses the default order of Parse.
+func (p *LangParser) ParseWith(value string, order ...MatchKind) (*Lang, MatchKind) {
+	if len(order) == 0 {
+		order = defaultParseOrder
+	}
+	code := value
+	if preferred, ok := grandfathered[stdBCP47Tag(value)]; ok {
+		code = preferred
+	}
+	if lang, kind := p.parseInOrder(code, order); lang != nil {
+		return lang, kind
+	}
+
+	p.mu.RLock()
+	retire := p.retire
+	p.mu.RUnlock()
+	if replacement, _, ok := Retirements(code); retire && ok && replacement != "" {
+		return p.parseInOrder(replacement, order)
+	}
+	return nil, MatchNone
+}
+
+func (p *LangParser) parseInOrder(value string, order []MatchKind) (*Lang, MatchKind) {
+	for _, kind := range order {
+		if lang := p.findByKind(value, kind); lang != nil {
+			return lang, kind
+		}
+	}
+	return nil, MatchNone
+}
+
+func (p *LangParser) findByKind(value string, kind MatchKind) *Lang {
+	switch kind {
+	case MatchBCP47:
+		return p.FindByBCP47(value)
+	case MatchWinID:
+		return p.FindByWinID(value)
+	case MatchISO3:
+		return p.FindByISO639Set3(NormalizeISOCode(value))
+	case MatchISO2:
+		return p.FindByISO639Set2(NormalizeISOCode(value))
+	case MatchISO1:
+		return p.FindByISO639Set1(NormalizeISOCode(value))
+	case MatchLCID:
+		lcid, err := strconv.ParseUint(value, 0, 32)
+		if err != nil {
+			return nil
+		}
+		return p.FindByMSLCID(uint32(lcid))
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package slang_test
+
+import (
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestParseWithMatch(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	tests := map[string]struct {
+		bcp47 string
+		kind  slang.MatchKind
+	}{
+		"en-US":      {"en-US", slang.MatchBCP47},
+		"zh_tw":      {"zh-TW", slang.MatchBCP47},
+		"CHS":        {"zh", slang.MatchWinID},
+		"wuu":        {"zh", slang.MatchISO3},
+		"iw":         {"he", slang.MatchISO1},
+		"zh-min-nan": {"zh", slang.MatchISO3},
+	}
+	for value, expected := range tests {
+		lang, kind := lp.ParseWithMatch(value)
+		if lang == nil || lang.BCP47 != expected.bcp47 || kind != expected.kind {
+			t.Errorf("Error: ParseWithMatch(%s) should be '%s' by %v, got %v by %v", value, expected.bcp47, expected.kind, lang, kind)
+		}
+	}
+
+	if lang, kind := lp.ParseWithMatch("invalid"); lang != nil || kind != slang.MatchNone {
+		t.Errorf("Error: ParseWithMatch(invalid) should be nil by None, got %v by %v", lang, kind)
+	}
+	if kind := slang.MatchWinID; kind.String() != "WinID" {
+		t.Errorf("Error: MatchWinID.String() should be 'WinID', got '%s'", kind)
+	}
+}
+
+func TestParseWith(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	if lang, kind := lp.ParseWith("0x0409", slang.MatchLCID); lang == nil || lang.BCP47 != "en-US" || kind != slang.MatchLCID {
+		t.Errorf("Error: ParseWith(0x0409, LCID) should be 'en-US' by LCID, got %v by %v", lang, kind)
+	}
+	if lang, kind := lp.ParseWith("1033", slang.MatchBCP47, slang.MatchLCID); lang == nil || lang.BCP47 != "en-US" || kind != slang.MatchLCID {
+		t.Errorf("Error: ParseWith(1033, BCP47, LCID) should be 'en-US' by LCID, got %v by %v", lang, kind)
+	}
+	if lang, _ := lp.ParseWith("CHS", slang.MatchBCP47, slang.MatchISO3); lang != nil {
+		t.Errorf("Error: ParseWith(CHS, BCP47, ISO3) should be nil, got %v", lang)
+	}
+	if lang, kind := lp.ParseWith("en"); lang == nil || kind != slang.MatchBCP47 {
+		t.Errorf("Error: ParseWith(en) should use the default order, got %v by %v", lang, kind)
+	}
+}
+
+func TestWithParseOrder(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	if lang, kind := lp.ParseWithMatch("EST"); lang == nil || lang.BCP47 != "et" || kind != slang.MatchISO3 {
+		t.Errorf("Error: ParseWithMatch(EST) should be 'et' by ISO639-3 by default, got %v by %v", lang, kind)
+	}
+
+	lp.WithParseOrder(slang.MatchWinID, slang.MatchBCP47)
+	if lang, kind := lp.ParseWithMatch("EST"); lang == nil || lang.BCP47 != "es-US" || kind != slang.MatchWinID {
+		t.Errorf("Error: ParseWithMatch(EST) should be 'es-US' by WinID, got %v by %v", lang, kind)
+	}
+	if lang := lp.Parse("wuu"); lang != nil {
+		t.Errorf("Error: Parse(wuu) should be nil when ISO codes are not in the order")
+	}
+	if lang := lp.Clone().Parse("EST"); lang == nil || lang.BCP47 != "es-US" {
+		t.Errorf("Error: Clone() should keep the parse order")
+	}
+
+	lp.WithParseOrder()
+	if lang, kind := lp.ParseWithMatch("EST"); lang == nil || lang.BCP47 != "et" || kind != slang.MatchISO3 {
+		t.Errorf("Error: WithParseOrder() should restore the default order, got %v by %v", lang, kind)
+	}
+}
@@ -0,0 +1,26 @@
+package slang
+
+// measurementSystems maps lower-cased ISO 3166-1 alpha-2 codes to the measurement systems, following CLDR.
+// Regions not in the table use the metric system.
+//
+// See: https://www.unicode.org/cldr/charts/latest/supplemental/territory_information.html
+var measurementSystems = map[string]string{
+	"us": "us", // United States
+	"lr": "us", // Liberia
+	"mm": "us", // Myanmar
+	"gb": "uk", // United Kingdom, using imperial units for distance and some volumes
+}
+
+// MeasurementSystem returns the measurement system used in the region: "metric", "us" for US customary units,
+// or "uk" for the mix of metric and imperial units used in United Kingdom.
+//
+// The region can be an ISO 3166-1 alpha-2, alpha-3 or numeric code (example: "US", "USA" or "840"), case insensitive.
+// Use RegionOf to get the region of a BCP47 tag.
+//
+// If the region is unknown, it will return "metric".
+func MeasurementSystem(region string) string {
+	if system, ok := measurementSystems[toLowerASCII(CountryAlpha2(region))]; ok {
+		return system
+	}
+	return "metric"
+}
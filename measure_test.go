@@ -0,0 +1,25 @@
+package slang_test
+
+import (
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestMeasurementSystem(t *testing.T) {
+	for region, expected := range map[string]string{
+		"US":  "us",
+		"usa": "us",
+		"LR":  "us",
+		"GB":  "uk",
+		"826": "uk",
+		"DE":  "metric",
+		"CA":  "metric",
+		"ZZ":  "metric",
+		"":    "metric",
+	} {
+		if system := slang.MeasurementSystem(region); system != expected {
+			t.Errorf("Error: MeasurementSystem(%s) should be '%s', got '%s'", region, expected, system)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package slang
+
+// numberSymbol is the decimal and grouping separator of a locale.
+type numberSymbol struct {
+	decimal string
+	group   string
+}
+
+// Separators used in the table below, other than "." and ",".
+const (
+	nbsp       = "\u00a0" // No-break space
+	narrowNBSP = "\u202f" // Narrow no-break space
+	apostrophe = "\u2019" // Right single quotation mark
+	arDecimal  = "\u066b" // Arabic decimal separator
+	arGroup    = "\u066c" // Arabic thousands separator
+)
+
+// numberSymbols maps lower-cased languages and language-region pairs to their number separators, following CLDR.
+//
+// See: https://www.unicode.org/cldr/charts/latest/by_type/numbers.symbols.html
+var numberSymbols = map[string]numberSymbol{
+	// Dot as decimal separator
+	"en": {".", ","}, "ja": {".", ","}, "ko": {".", ","}, "zh": {".", ","}, "th": {".", ","},
+	"he": {".", ","}, "hi": {".", ","}, "ms": {".", ","}, "sw": {".", ","}, "ga": {".", ","},
+	"es-mx": {".", ","}, "es-us": {".", ","}, "es-419": {".", ","},
+	"de-ch": {".", apostrophe}, "de-li": {".", apostrophe}, "it-ch": {".", apostrophe},
+
+	// Comma as decimal separator and dot as grouping separator
+	"de": {",", "."}, "es": {",", "."}, "it": {",", "."}, "pt": {",", "."}, "nl": {",", "."},
+	"da": {",", "."}, "tr": {",", "."}, "el": {",", "."}, "ro": {",", "."}, "hr": {",", "."},
+	"sr": {",", "."}, "sl": {",", "."}, "id": {",", "."}, "vi": {",", "."}, "ca": {",", "."},
+	"is": {",", "."},
+
+	// Comma as decimal separator and space as grouping separator
+	"fr": {",", narrowNBSP}, "ru": {",", nbsp}, "uk": {",", nbsp}, "pl": {",", nbsp}, "cs": {",", nbsp},
+	"sk": {",", nbsp}, "sv": {",", nbsp}, "nb": {",", nbsp}, "no": {",", nbsp}, "nn": {",", nbsp},
+	"fi": {",", nbsp}, "hu": {",", nbsp}, "bg": {",", nbsp}, "et": {",", nbsp}, "lv": {",", nbsp},
+	"lt": {",", nbsp}, "de-at": {",", nbsp}, "fr-ca": {",", nbsp}, "pt-pt": {",", nbsp}, "en-za": {",", nbsp},
+
+	// Arabic separators, used with Arabic-Indic digits
+	"ar": {arDecimal, arGroup}, "fa": {arDecimal, arGroup},
+	"ar-ma": {",", "."}, "ar-dz": {",", "."}, "ar-tn": {",", "."},
+}
+
+// NumberSymbols returns the decimal separator and the grouping separator of the locale of the BCP47 tag,
+// from an embedded table covering the most used languages (example: "," and "." for de-DE).
+//
+// Case insensitive, support both dash (-) and underscore (_) as separator.
+//
+// The language-region pair is looked up first, then the language (example: de-CH is "." and "’", de-DE is as de).
+// Spaces used as grouping separator are no-break spaces (U+00A0 or U+202F), as in CLDR.
+// If the locale is unknown, it will return "." and ",".
+func NumberSymbols(bcp47 string) (decimal, group string) {
+	parts := splitTag(trimExtensions(bcp47))
+	if symbol, ok := numberSymbols[parts.language+"-"+parts.region]; ok {
+		return symbol.decimal, symbol.group
+	}
+	if symbol, ok := numberSymbols[parts.language]; ok {
+		return symbol.decimal, symbol.group
+	}
+	return ".", ","
+}
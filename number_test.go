@@ -0,0 +1,29 @@
+package slang_test
+
+import (
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestNumberSymbols(t *testing.T) {
+	for tag, expected := range map[string][2]string{
+		"en-US":   {".", ","},
+		"de-DE":   {",", "."},
+		"de":      {",", "."},
+		"de_ch":   {".", "\u2019"},
+		"fr-FR":   {",", "\u202f"},
+		"ru":      {",", "\u00a0"},
+		"es-MX":   {".", ","},
+		"es-ES":   {",", "."},
+		"pt-BR":   {",", "."},
+		"pt-PT":   {",", "\u00a0"},
+		"zh-Hant": {".", ","},
+		"tlh":     {".", ","},
+		"":        {".", ","},
+	} {
+		if decimal, group := slang.NumberSymbols(tag); decimal != expected[0] || group != expected[1] {
+			t.Errorf("Error: NumberSymbols(%s) should be %q and %q, got %q and %q", tag, expected[0], expected[1], decimal, group)
+		}
+	}
+}
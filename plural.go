@@ -0,0 +1,105 @@
+package slang
+
+// Sets of CLDR cardinal plural categories, in the order of CLDR: zero, one, two, few, many, other.
+var (
+	pluralOther                  = []string{"other"}
+	pluralOneOther               = []string{"one", "other"}
+	pluralOneManyOther           = []string{"one", "many", "other"}
+	pluralZeroOneOther           = []string{"zero", "one", "other"}
+	pluralOneTwoOther            = []string{"one", "two", "other"}
+	pluralOneFewOther            = []string{"one", "few", "other"}
+	pluralOneFewManyOther        = []string{"one", "few", "many", "other"}
+	pluralOneTwoFewOther         = []string{"one", "two", "few", "other"}
+	pluralOneTwoFewManyOther     = []string{"one", "two", "few", "many", "other"}
+	pluralZeroOneTwoFewManyOther = []string{"zero", "one", "two", "few", "many", "other"}
+)
+
+// pluralCategories maps ISO 639-1 codes, or ISO 639-3 codes for languages without one,
+// to the CLDR cardinal plural categories of the languages.
+//
+// See: https://www.unicode.org/cldr/charts/latest/supplemental/language_plural_rules.html
+var pluralCategories = map[string][]string{
+	// No plural forms
+	"bo": pluralOther, "dz": pluralOther, "id": pluralOther, "ig": pluralOther, "ii": pluralOther,
+	"ja": pluralOther, "jv": pluralOther, "km": pluralOther, "ko": pluralOther, "lo": pluralOther,
+	"ms": pluralOther, "my": pluralOther, "sg": pluralOther, "su": pluralOther, "th": pluralOther,
+	"to": pluralOther, "vi": pluralOther, "wo": pluralOther, "yo": pluralOther, "yue": pluralOther,
+	"zh": pluralOther,
+
+	// Singular and plural
+	"af": pluralOneOther, "ak": pluralOneOther, "am": pluralOneOther, "as": pluralOneOther, "ast": pluralOneOther,
+	"az": pluralOneOther, "bg": pluralOneOther, "bn": pluralOneOther, "ce": pluralOneOther, "ceb": pluralOneOther,
+	"chr": pluralOneOther, "ckb": pluralOneOther, "da": pluralOneOther, "de": pluralOneOther, "dv": pluralOneOther,
+	"ee": pluralOneOther, "el": pluralOneOther, "en": pluralOneOther, "eo": pluralOneOther, "et": pluralOneOther,
+	"eu": pluralOneOther, "fa": pluralOneOther, "ff": pluralOneOther, "fi": pluralOneOther, "fil": pluralOneOther,
+	"fo": pluralOneOther, "fy": pluralOneOther, "gl": pluralOneOther, "gu": pluralOneOther, "ha": pluralOneOther,
+	"haw": pluralOneOther, "hi": pluralOneOther, "hu": pluralOneOther, "hy": pluralOneOther, "ia": pluralOneOther,
+	"is": pluralOneOther, "ka": pluralOneOther, "kk": pluralOneOther, "kl": pluralOneOther, "kn": pluralOneOther,
+	"ks": pluralOneOther, "ku": pluralOneOther, "ky": pluralOneOther, "lb": pluralOneOther, "lg": pluralOneOther,
+	"ln": pluralOneOther, "mg": pluralOneOther, "mk": pluralOneOther, "ml": pluralOneOther, "mn": pluralOneOther,
+	"mr": pluralOneOther, "nb": pluralOneOther, "ne": pluralOneOther, "nl": pluralOneOther, "nn": pluralOneOther,
+	"no": pluralOneOther, "ny": pluralOneOther, "om": pluralOneOther, "or": pluralOneOther, "os": pluralOneOther,
+	"pa": pluralOneOther, "ps": pluralOneOther, "rm": pluralOneOther, "sd": pluralOneOther, "si": pluralOneOther,
+	"sn": pluralOneOther, "so": pluralOneOther, "sq": pluralOneOther, "st": pluralOneOther, "sv": pluralOneOther,
+	"sw": pluralOneOther, "ta": pluralOneOther, "te": pluralOneOther, "ti": pluralOneOther, "tk": pluralOneOther,
+	"tl": pluralOneOther, "tn": pluralOneOther, "tr": pluralOneOther, "ts": pluralOneOther, "ug": pluralOneOther,
+	"ur": pluralOneOther, "uz": pluralOneOther, "xh": pluralOneOther, "yi": pluralOneOther, "zu": pluralOneOther,
+
+	// Singular, plural and a form for large numbers (example: "de millions" in French)
+	"ca": pluralOneManyOther, "es": pluralOneManyOther, "fr": pluralOneManyOther, "it": pluralOneManyOther,
+	"pt": pluralOneManyOther,
+
+	// Latvian
+	"lv": pluralZeroOneOther,
+
+	// Hebrew, Inuktitut and Sami languages
+	"he": pluralOneTwoOther, "iu": pluralOneTwoOther, "se": pluralOneTwoOther, "smj": pluralOneTwoOther,
+	"smn": pluralOneTwoOther, "sms": pluralOneTwoOther,
+
+	// Bosnian, Croatian, Serbian and Romanian
+	"bs": pluralOneFewOther, "hr": pluralOneFewOther, "sr": pluralOneFewOther, "ro": pluralOneFewOther,
+
+	// Belarusian, Czech, Lithuanian, Polish, Russian, Slovak and Ukrainian
+	"be": pluralOneFewManyOther, "cs": pluralOneFewManyOther, "lt": pluralOneFewManyOther, "pl": pluralOneFewManyOther,
+	"ru": pluralOneFewManyOther, "sk": pluralOneFewManyOther, "uk": pluralOneFewManyOther,
+
+	// Slovenian, Sorbian and Scottish Gaelic
+	"sl": pluralOneTwoFewOther, "dsb": pluralOneTwoFewOther, "hsb": pluralOneTwoFewOther, "gd": pluralOneTwoFewOther,
+
+	// Breton, Irish, Manx and Maltese
+	"br": pluralOneTwoFewManyOther, "ga": pluralOneTwoFewManyOther, "gv": pluralOneTwoFewManyOther,
+	"mt": pluralOneTwoFewManyOther,
+
+	// Arabic, Cornish and Welsh
+	"ar": pluralZeroOneTwoFewManyOther, "kw": pluralZeroOneTwoFewManyOther, "cy": pluralZeroOneTwoFewManyOther,
+}
+
+// PluralCategories returns the CLDR cardinal plural categories used by the language of the ISO 639 code
+// (639-1, 639-2 or 639-3), in the order of zero, one, two, few, many and other.
+//
+// # Examples
+//  1. "en" will return [one other].
+//  2. "ara" will return [zero one two few many other].
+//  3. "ja" will return [other].
+//
+// Case insensitive. Codes not in the embedded table, which covers the most used languages,
+// are resolved to their ISO 639-1 code by the embedded language database (example: "cmn" as "zh").
+// Languages added to or removed from parsers do not change the result.
+// If the language is unknown, it will return [other], which is valid for every language.
+func PluralCategories(iso639 string) []string {
+	code := toLowerASCII(NormalizeISOCode(iso639))
+	categories, ok := pluralCategories[code]
+	for i := 0; !ok && code != "" && i < len(langDB); i++ {
+		lang := langDB[i]
+		if !equalFoldASCII(lang.ISO639Set1, code) && !equalFoldASCII(lang.ISO639Set2, code) && !equalFoldASCII(lang.ISO639Set3, code) {
+			continue
+		}
+		if categories, ok = pluralCategories[toLowerASCII(lang.ISO639Set3)]; !ok {
+			categories, ok = pluralCategories[toLowerASCII(lang.ISO639Set1)]
+		}
+	}
+	if !ok {
+		categories = pluralOther
+	}
+	return append([]string(nil), categories...)
+}
@@ -0,0 +1,46 @@
+package slang_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestPluralCategories(t *testing.T) {
+	for code, expected := range map[string][]string{
+		"en":  {"one", "other"},
+		"ENG": {"one", "other"},
+		"ar":  {"zero", "one", "two", "few", "many", "other"},
+		"ara": {"zero", "one", "two", "few", "many", "other"},
+		"ru":  {"one", "few", "many", "other"},
+		"fr":  {"one", "many", "other"},
+		"ja":  {"other"},
+		"cmn": {"other"},
+		"iw":  {"one", "two", "other"},
+		"tlh": {"other"},
+		"":    {"other"},
+	} {
+		if categories := slang.PluralCategories(code); !reflect.DeepEqual(categories, expected) {
+			t.Errorf("Error: PluralCategories(%s) should be %v, got %v", code, expected, categories)
+		}
+	}
+
+	slang.PluralCategories("en")[0] = "modified"
+	if categories := slang.PluralCategories("de"); categories[0] != "one" {
+		t.Errorf("Error: modifying the result of PluralCategories should not affect the table, got %v", categories)
+	}
+}
+
+func TestPluralCategoriesIgnoresDefaultParser(t *testing.T) {
+	lp, err := slang.Default()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	lp.AddCustom(slang.Lang{Name: "Klingon", BCP47: "tlh", ISO639Set1: "ru", ISO639Set2: "tlh", ISO639Set3: "tlh"})
+	defer lp.RemoveByBCP47("tlh")
+
+	if categories := slang.PluralCategories("tlh"); !reflect.DeepEqual(categories, []string{"other"}) {
+		t.Errorf("Error: PluralCategories(tlh) should not depend on the default parser, got %v", categories)
+	}
+}
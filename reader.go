@@ -0,0 +1,38 @@
+package slang
+
+// Reader is the read-only part of LangParser, having the Parse and Find* methods only.
+//
+// A *LangParser can be passed as a Reader to code which should only query the languages,
+// such as plugins sharing a parser, so it can not call AddCustom, LoadCSV or other methods changing the parser.
+// Languages returned are copies, so modifying them does not change the parser either.
+type Reader interface {
+	Parse(value string) *Lang
+	ParseE(value string) (*Lang, error)
+	ParseWithMatch(value string) (*Lang, MatchKind)
+	ParseMany(codes []string) []*Lang
+
+	FindAllByBCP47(bcp47 string) []Lang
+	FindAllByWinID(winID string) []Lang
+	FindAllByISO639Set1(iso639 string) []Lang
+	FindAllByISO639Set2(iso639 string) []Lang
+	FindAllByISO639Set3(iso639 string) []Lang
+	FindAllByISOCode(iso639 string) []Lang
+	FindAllByMSLCID(lcid uint32) []Lang
+
+	FindByBCP47(bcp47 string) *Lang
+	FindByWinID(winID string) *Lang
+	FindByISO639Set1(iso639 string) *Lang
+	FindByISO639Set2(iso639 string) *Lang
+	FindByISO639Set3(iso639 string) *Lang
+	FindByISOCode(iso639 string) *Lang
+	FindByMSLCID(lcid uint32) *Lang
+
+	FindByBCP47E(bcp47 string) (*Lang, error)
+	FindByWinIDE(winID string) (*Lang, error)
+	FindByISO639Set1E(iso639 string) (*Lang, error)
+	FindByISO639Set2E(iso639 string) (*Lang, error)
+	FindByISO639Set3E(iso639 string) (*Lang, error)
+	FindByISOCodeE(iso639 string) (*Lang, error)
+}
+
+var _ Reader = (*LangParser)(nil)
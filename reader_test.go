@@ -0,0 +1,24 @@
+package slang_test
+
+import (
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestReader(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	var r slang.Reader = lp
+	if lang := r.Parse("en-US"); lang == nil || lang.BCP47 != "en-US" {
+		t.Errorf("Error: Reader.Parse(en-US) should be 'en-US', got %v", lang)
+	}
+
+	r.FindByBCP47("fr").Name = "Modified"
+	if lang := lp.FindByBCP47("fr"); lang.Name != "French" {
+		t.Errorf("Error: modifying a language returned by Reader should not change the parser, got %q", lang.Name)
+	}
+}
@@ -0,0 +1,171 @@
+package slang
+
+import "strings"
+
+// FlagEmoji returns the flag emoji of the region of the language, built from Unicode regional indicator symbols
+// (example: 🇺🇸 for en-US).
+//
+// It returns an empty string when the language has no region, or the region is a three-digit UN M49 code
+// (example: es-419), which has no flag.
+func (lang Lang) FlagEmoji() string {
+	region := lang.Region()
+	if len(region) != 2 || !isASCIIAlpha(region) {
+		return ""
+	}
+
+	flag := make([]rune, 0, 2)
+	for _, c := range region {
+		flag = append(flag, 0x1F1E6+(c-'A'))
+	}
+	return string(flag)
+}
+
+// FindClosestRegion returns the language matching the BCP47 tag exactly, or else the closest regional sibling,
+// which is another language sharing the same language subtag and having a region subtag.
+//
+// Case insensitive, support both dash (-) and underscore (_) as separator.
+//
+// Siblings are ranked deterministically: first the ones with the same script as the tag after AddLikelySubtags,
+// then the one in the most likely region of the language, then by BCP47 tag length and alphabetical order.
+// If there is no sibling with a region, it falls back to FindByBCP47.
+//
+// # Examples
+//  1. "en-US" will return "en-US".
+//  2. "en-XX" will return "en-US", which is the most likely region of English.
+//  3. "zh-Hant-XX" will return "zh-TW", and "zh-Hans-XX" will return "zh-CN".
+//  4. "sr-Latn-XX" will return "sr-Latn-RS".
+//
+// If no value is found, it will return nil.
+func (p *LangParser) FindClosestRegion(bcp47 string) *Lang {
+	tag := CanonicalizeBCP47(bcp47)
+	language := splitTag(tag).language
+	candidates := p.Where(func(lang Lang) bool {
+		return splitTag(lang.BCP47).language == language
+	})
+	for i := range candidates {
+		if equalFoldASCII(candidates[i].BCP47, tag) {
+			return &candidates[i]
+		}
+	}
+
+	script := ScriptOf(AddLikelySubtags(tag))
+	region := RegionOf(AddLikelySubtags(strings.TrimSuffix(language+"-"+script, "-")))
+	sortByBCP47Tag(candidates)
+	best, bestScore := -1, -1
+	for i, lang := range candidates {
+		if lang.Region() == "" {
+			continue
+		}
+		score := 0
+		if ScriptOf(AddLikelySubtags(lang.BCP47)) == script {
+			score += 2
+		}
+		if lang.Region() == region {
+			score++
+		}
+		if score > bestScore {
+			best, bestScore = i, score
+		}
+	}
+	if best < 0 {
+		return p.FindByBCP47(bcp47)
+	}
+	return &candidates[best]
+}
+
+// CountByRegion returns the number of distinct languages associated with each region, keyed by the region subtag
+// in upper case (example: "US", "IN" or "419").
+//
+// Languages are counted by their language subtag, so "sr-Cyrl-RS" and "sr-Latn-RS" count as one language for "RS".
+// Languages without a region subtag are skipped.
+func (p *LangParser) CountByRegion() map[string]int {
+	seen := map[[2]string]bool{}
+	counts := map[string]int{}
+	for lang := range p.All() {
+		region := lang.Region()
+		key := [2]string{region, splitTag(lang.BCP47).language}
+		if region == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		counts[region]++
+	}
+	return counts
+}
+
+// FindRegionalVariants returns the languages of the ISO 639 code having a region subtag (example: en-US and en-GB for "en").
+//
+// The languages are found by FindAllByISOCode, so it is case insensitive and the result is sorted by BCP47 tag length.
+//
+// If no value is found, it will return an empty slice.
+func (p *LangParser) FindRegionalVariants(iso639 string) []Lang {
+	results := []Lang{}
+	for _, lang := range p.FindAllByISOCode(iso639) {
+		if lang.Region() != "" {
+			results = append(results, lang)
+		}
+	}
+	return results
+}
+
+// FindNeutral returns the first language of the ISO 639 code without a region subtag (example: en for "en").
+//
+// The languages are found by FindAllByISOCode, so it is case insensitive, and the one with the shortest BCP47 tag
+// is returned. A language with only a script subtag is also neutral (example: zh-Hant).
+//
+// If no value is found, it will return nil.
+func (p *LangParser) FindNeutral(iso639 string) *Lang {
+	for _, lang := range p.FindAllByISOCode(iso639) {
+		if lang.Region() == "" {
+			return &lang
+		}
+	}
+	return nil
+}
+
+// m49Regions maps the UN M49 codes of the macro regions to their English names, following CLDR.
+//
+// See: https://unstats.un.org/unsd/methodology/m49/
+var m49Regions = map[string]string{
+	"001": "World",
+	"002": "Africa",
+	"003": "North America",
+	"005": "South America",
+	"009": "Oceania",
+	"011": "Western Africa",
+	"013": "Central America",
+	"014": "Eastern Africa",
+	"015": "Northern Africa",
+	"017": "Middle Africa",
+	"018": "Southern Africa",
+	"019": "Americas",
+	"021": "Northern America",
+	"029": "Caribbean",
+	"030": "Eastern Asia",
+	"034": "Southern Asia",
+	"035": "Southeast Asia",
+	"039": "Southern Europe",
+	"053": "Australasia",
+	"054": "Melanesia",
+	"057": "Micronesian Region",
+	"061": "Polynesia",
+	"142": "Asia",
+	"143": "Central Asia",
+	"145": "Western Asia",
+	"150": "Europe",
+	"151": "Eastern Europe",
+	"154": "Northern Europe",
+	"155": "Western Europe",
+	"202": "Sub-Saharan Africa",
+	"419": "Latin America",
+}
+
+// MacroRegion returns the English name of the region of the BCP47 tag if it is a UN M49 macro region
+// (example: "Latin America" for es-419, "World" for en-001).
+//
+// Case insensitive, support both dash (-) and underscore (_) as separator.
+//
+// If the tag has no region, or the region is a country (example: en-US), it will return empty string.
+func MacroRegion(bcp47 string) string {
+	return m49Regions[RegionOf(bcp47)]
+}
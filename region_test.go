@@ -0,0 +1,179 @@
+package slang_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestFlagEmoji(t *testing.T) {
+	for tag, expected := range map[string]string{
+		"en-US":       "🇺🇸",
+		"zh-TW":       "🇹🇼",
+		"bho-Deva-IN": "🇮🇳",
+		"fr":          "",
+		"zh-Hans":     "",
+		"es-419":      "",
+		"ar-001":      "",
+	} {
+		if flag := (slang.Lang{BCP47: tag}).FlagEmoji(); flag != expected {
+			t.Errorf("Error: FlagEmoji() of %s should be '%s', got '%s'", tag, expected, flag)
+		}
+	}
+}
+
+func TestFindClosestRegion(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	tests := map[string]string{
+		"en-US":      "en-US",
+		"en_gb":      "en-GB",
+		"en-XX":      "en-US",
+		"zh-Hant-XX": "zh-TW",
+		"zh-Hans-XX": "zh-CN",
+		"zh-XX":      "zh-CN",
+		"sr-Latn-XX": "sr-Latn-RS",
+		"zh-Hant":    "zh-Hant",
+	}
+	for tag, expected := range tests {
+		if lang := lp.FindClosestRegion(tag); lang == nil || lang.BCP47 != expected {
+			t.Errorf("Error: FindClosestRegion(%s) should be '%s', got %v", tag, expected, lang)
+		}
+	}
+	if lang := lp.FindClosestRegion("invalid"); lang != nil {
+		t.Errorf("Error: FindClosestRegion(invalid) should be nil")
+	}
+
+	custom, err := slang.NewParserFromReader(strings.NewReader(""))
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	custom.AddCustom(slang.Lang{Name: "English", BCP47: "en"}).
+		AddCustom(slang.Lang{Name: "English", Location: "United Kingdom", BCP47: "en-GB"}).
+		AddCustom(slang.Lang{Name: "English", Location: "United States", BCP47: "en-US"})
+	if lang := custom.FindClosestRegion("en-AU"); lang == nil || lang.BCP47 != "en-US" {
+		t.Errorf("Error: FindClosestRegion(en-AU) should be 'en-US', got %v", lang)
+	}
+	custom.RemoveByBCP47("en-US")
+	if lang := custom.FindClosestRegion("en-AU"); lang == nil || lang.BCP47 != "en-GB" {
+		t.Errorf("Error: FindClosestRegion(en-AU) should be 'en-GB', got %v", lang)
+	}
+}
+
+func TestCountByRegion(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	counts := lp.CountByRegion()
+	if _, ok := counts[""]; ok {
+		t.Errorf("Error: CountByRegion() should skip languages without region")
+	}
+	if counts["IN"] < 10 || counts["US"] < 2 || counts["CN"] < 3 {
+		t.Errorf("Error: CountByRegion() should count multiple languages for IN, US and CN, got %v", counts)
+	}
+
+	custom, err := slang.NewParserFromReader(strings.NewReader(""))
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	custom.AddCustom(slang.Lang{BCP47: "sr-Cyrl-RS"}).
+		AddCustom(slang.Lang{BCP47: "sr-Latn-RS"}).
+		AddCustom(slang.Lang{BCP47: "hu-RS"}).
+		AddCustom(slang.Lang{BCP47: "sr"})
+	if counts := custom.CountByRegion(); len(counts) != 1 || counts["RS"] != 2 {
+		t.Errorf("Error: CountByRegion() should be map[RS:2], got %v", counts)
+	}
+}
+
+func TestFindRegionalVariants(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	langs := lp.FindRegionalVariants("EN")
+	if len(langs) < 2 || !hasBCP47(langs, "en-US") || !hasBCP47(langs, "en-GB") || hasBCP47(langs, "en") {
+		t.Errorf("Error: FindRegionalVariants(EN) should have en-US and en-GB but no en, got %v", langs)
+	}
+	for _, lang := range langs {
+		if lang.Region() == "" {
+			t.Errorf("Error: FindRegionalVariants(EN) should not have '%s'", lang.BCP47)
+		}
+	}
+	if langs := lp.FindRegionalVariants("xxx"); len(langs) != 0 {
+		t.Errorf("Error: FindRegionalVariants(xxx) should be empty, got %v", langs)
+	}
+}
+
+func TestFindNeutral(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	for code, expected := range map[string]string{
+		"en":  "en",
+		"eng": "en",
+		"zh":  "zh",
+		"fr":  "fr",
+	} {
+		if lang := lp.FindNeutral(code); lang == nil || lang.BCP47 != expected {
+			t.Errorf("Error: FindNeutral(%s) should be '%s', got %v", code, expected, lang)
+		}
+	}
+	if lang := lp.FindNeutral("xxx"); lang != nil {
+		t.Errorf("Error: FindNeutral(xxx) should be nil, got %v", lang)
+	}
+
+	custom, err := slang.NewParserFromReader(strings.NewReader(""))
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	custom.AddCustom(slang.Lang{BCP47: "kg-SU", ISO639Set3: "tlh"})
+	if lang := custom.FindNeutral("tlh"); lang != nil {
+		t.Errorf("Error: FindNeutral(tlh) without neutral language should be nil, got %v", lang)
+	}
+}
+
+func TestMacroRegion(t *testing.T) {
+	tests := map[string]string{
+		"es-419":      "Latin America",
+		"es_419":      "Latin America",
+		"en-001":      "World",
+		"en-150":      "Europe",
+		"zh-Hans-029": "Caribbean",
+		"en-US":       "",
+		"es":          "",
+		"es-999":      "",
+	}
+	for tag, expected := range tests {
+		if name := slang.MacroRegion(tag); name != expected {
+			t.Errorf("Error: MacroRegion(%s) should be '%s', got '%s'", tag, expected, name)
+		}
+	}
+}
+
+func TestM49Region(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	lang := lp.FindByBCP47("es-419")
+	if lang == nil || lang.BCP47 != "es-419" || lang.Region() != "419" || lang.Script() != "" {
+		t.Errorf("Error: FindByBCP47(es-419) should be 'es-419' with region '419' and no script, got %v", lang)
+	}
+	tags := slang.Select(lp.FindAllByBCP47("es-419-x-custom"), func(lang slang.Lang) string { return lang.BCP47 })
+	if len(tags) != 2 || tags[0] != "es-419" || tags[1] != "es" {
+		t.Errorf("Error: FindAllByBCP47(es-419-x-custom) should be [es-419 es], got %v", tags)
+	}
+	if lang := lp.FindByBCP47("es-418"); lang == nil || lang.BCP47 != "es" {
+		t.Errorf("Error: FindByBCP47(es-418) should fall back to 'es', got %v", lang)
+	}
+}
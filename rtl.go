@@ -0,0 +1,106 @@
+package slang
+
+// rtlScripts is the set of ISO 15924 scripts written from right to left, in lower case.
+var rtlScripts = map[string]bool{
+	"adlm": true, // Adlam
+	"arab": true, // Arabic
+	"aran": true, // Arabic (Nastaliq variant)
+	"armi": true, // Imperial Aramaic
+	"avst": true, // Avestan
+	"chrs": true, // Chorasmian
+	"cprt": true, // Cypriot syllabary
+	"elym": true, // Elymaic
+	"hatr": true, // Hatran
+	"hebr": true, // Hebrew
+	"hung": true, // Old Hungarian
+	"khar": true, // Kharoshthi
+	"lydi": true, // Lydian
+	"mand": true, // Mandaic
+	"mani": true, // Manichaean
+	"mend": true, // Mende Kikakui
+	"merc": true, // Meroitic Cursive
+	"mero": true, // Meroitic Hieroglyphs
+	"narb": true, // Old North Arabian
+	"nbat": true, // Nabataean
+	"nkoo": true, // N'Ko
+	"orkh": true, // Old Turkic
+	"ougr": true, // Old Uyghur
+	"palm": true, // Palmyrene
+	"phli": true, // Inscriptional Pahlavi
+	"phlp": true, // Psalter Pahlavi
+	"phnx": true, // Phoenician
+	"prti": true, // Inscriptional Parthian
+	"rohg": true, // Hanifi Rohingya
+	"samr": true, // Samaritan
+	"sarb": true, // Old South Arabian
+	"sogd": true, // Sogdian
+	"sogo": true, // Old Sogdian
+	"syrc": true, // Syriac
+	"thaa": true, // Thaana
+	"yezi": true, // Yezidi
+}
+
+// rtlCodes is the set of ISO 639 codes of languages written from right to left by default, in lower case.
+var rtlCodes = map[string]bool{
+	// Arabic and its sublanguages
+	"ar": true, "ara": true,
+	"aao": true, "abh": true, "abv": true, "acm": true, "acq": true, "acw": true, "acx": true, "acy": true,
+	"adf": true, "aeb": true, "aec": true, "afb": true, "ajp": true, "apc": true, "apd": true, "arb": true,
+	"arq": true, "ars": true, "ary": true, "arz": true, "auz": true, "avl": true, "ayh": true, "ayl": true,
+	"ayn": true, "ayp": true, "bbz": true, "shu": true, "ssh": true,
+
+	// Hebrew
+	"he": true, "heb": true, "iw": true,
+
+	// Persian
+	"fa": true, "fas": true, "per": true, "pes": true, "prs": true,
+
+	// Urdu
+	"ur": true, "urd": true,
+
+	// Yiddish
+	"yi": true, "yid": true, "ydd": true, "yih": true, "ji": true,
+
+	// Pashto
+	"ps": true, "pus": true, "pbt": true, "pbu": true, "pst": true,
+
+	// Kashmiri, Sindhi, Uyghur
+	"ks": true, "kas": true, "sd": true, "snd": true, "ug": true, "uig": true,
+
+	// Kurdish (Central and Southern), Luri, Balochi
+	"ckb": true, "sdh": true, "lrc": true, "bal": true, "bcc": true, "bgn": true, "bgp": true,
+
+	// South Azerbaijani, Bakhtiari, Gilaki, Mazanderani, Khowar, Western Panjabi, Saraiki
+	"azb": true, "bqi": true, "glk": true, "mzn": true, "khw": true, "pnb": true, "skr": true,
+
+	// Divehi
+	"dv": true, "div": true,
+
+	// Syriac, Aramaic
+	"syr": true, "syc": true, "aii": true, "cld": true, "arc": true, "sam": true,
+
+	// N'Ko
+	"nqo": true,
+}
+
+// IsRTLCode checks if the language of the ISO 639 code (639-1, 639-2 or 639-3) is written from right to left by default.
+//
+// Case insensitive. This is based on a list of known right-to-left languages maintained in this package,
+// covering Arabic, Hebrew, Persian, Urdu, Yiddish, Pashto and other languages written in right-to-left scripts.
+func IsRTLCode(iso string) bool {
+	return rtlCodes[toLowerASCII(iso)]
+}
+
+// IsRTL checks if the language is written from right to left.
+//
+// If the BCP47 tag has a script subtag (example: pa-Arab), the direction of the script is used.
+// Otherwise, it is determined by the ISO 639-3 code (or ISO 639-1 code when ISO 639-3 is empty), see IsRTLCode.
+func (lang Lang) IsRTL() bool {
+	if script := ScriptOf(lang.BCP47); script != "" {
+		return rtlScripts[toLowerASCII(script)]
+	}
+	if lang.ISO639Set3 != "" {
+		return IsRTLCode(lang.ISO639Set3)
+	}
+	return IsRTLCode(lang.ISO639Set1)
+}
@@ -0,0 +1,64 @@
+package slang_test
+
+import (
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestIsRTLCode(t *testing.T) {
+	for code, expected := range map[string]bool{
+		"ar":  true,
+		"ARA": true,
+		"he":  true,
+		"heb": true,
+		"fa":  true,
+		"ur":  true,
+		"yi":  true,
+		"ps":  true,
+		"arz": true,
+		"en":  false,
+		"zho": false,
+		"":    false,
+	} {
+		if slang.IsRTLCode(code) != expected {
+			t.Errorf("Error: IsRTLCode(%s) should be %v", code, expected)
+		}
+	}
+}
+
+func TestLangIsRTL(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	for tag, expected := range map[string]bool{
+		"ar":         true,
+		"ar-SA":      true,
+		"he-IL":      true,
+		"fa-IR":      true,
+		"ur-PK":      true,
+		"yi":         true,
+		"ps-AF":      true,
+		"pa-Arab-PK": true,
+		"pa-IN":      false,
+		"ks-Deva-IN": false,
+		"uz-Arab-AF": true,
+		"en-US":      false,
+		"zh-TW":      false,
+	} {
+		lang := lp.FindByBCP47(tag)
+		if lang == nil || lang.BCP47 != tag {
+			t.Errorf("Error: FindByBCP47(%s) should be '%s'", tag, tag)
+			continue
+		}
+		if lang.IsRTL() != expected {
+			t.Errorf("Error: IsRTL() of %s should be %v", tag, expected)
+		}
+	}
+
+	if !lp.FindByISO639Set3("arz").IsRTL() {
+		t.Errorf("Error: IsRTL() of Egyptian Arabic (arz) should be true")
+	}
+}
@@ -0,0 +1,204 @@
+package slang
+
+// scriptNames is the ISO 15924 table, mapping the script codes in lower case to their English names.
+//
+// See: https://www.unicode.org/iso15924/
+var scriptNames = map[string]string{
+	"adlm": "Adlam",
+	"afak": "Afaka",
+	"aghb": "Caucasian Albanian",
+	"ahom": "Ahom, Tai Ahom",
+	"arab": "Arabic",
+	"aran": "Arabic (Nastaliq variant)",
+	"armi": "Imperial Aramaic",
+	"armn": "Armenian",
+	"avst": "Avestan",
+	"bali": "Balinese",
+	"bamu": "Bamum",
+	"bass": "Bassa Vah",
+	"batk": "Batak",
+	"beng": "Bengali",
+	"bhks": "Bhaiksuki",
+	"blis": "Blissymbols",
+	"bopo": "Bopomofo",
+	"brah": "Brahmi",
+	"brai": "Braille",
+	"bugi": "Buginese",
+	"buhd": "Buhid",
+	"cakm": "Chakma",
+	"cans": "Unified Canadian Aboriginal Syllabics",
+	"cari": "Carian",
+	"cham": "Cham",
+	"cher": "Cherokee",
+	"cirt": "Cirth",
+	"copt": "Coptic",
+	"cprt": "Cypriot",
+	"cyrl": "Cyrillic",
+	"cyrs": "Cyrillic (Old Church Slavonic variant)",
+	"deva": "Devanagari (Nagari)",
+	"dsrt": "Deseret (Mormon)",
+	"dupl": "Duployan shorthand, Duployan stenography",
+	"egyd": "Egyptian demotic",
+	"egyh": "Egyptian hieratic",
+	"egyp": "Egyptian hieroglyphs",
+	"elba": "Elbasan",
+	"ethi": "Ethiopic (Geʻez)",
+	"geok": "Khutsuri (Asomtavruli and Nuskhuri)",
+	"geor": "Georgian (Mkhedruli)",
+	"glag": "Glagolitic",
+	"goth": "Gothic",
+	"gran": "Grantha",
+	"grek": "Greek",
+	"gujr": "Gujarati",
+	"guru": "Gurmukhi",
+	"hanb": "Han with Bopomofo (alias for Han + Bopomofo)",
+	"hang": "Hangul (Hangŭl, Hangeul)",
+	"hani": "Han (Hanzi, Kanji, Hanja)",
+	"hano": "Hanunoo (Hanunóo)",
+	"hans": "Han (Simplified variant)",
+	"hant": "Han (Traditional variant)",
+	"hatr": "Hatran",
+	"hebr": "Hebrew",
+	"hira": "Hiragana",
+	"hluw": "Anatolian Hieroglyphs (Luwian Hieroglyphs, Hittite Hieroglyphs)",
+	"hmng": "Pahawh Hmong",
+	"hrkt": "Japanese syllabaries (alias for Hiragana + Katakana)",
+	"hung": "Old Hungarian (Hungarian Runic)",
+	"inds": "Indus (Harappan)",
+	"ital": "Old Italic (Etruscan, Oscan, etc.)",
+	"jamo": "Jamo (alias for Jamo subset of Hangul)",
+	"java": "Javanese",
+	"jpan": "Japanese (alias for Han + Hiragana + Katakana)",
+	"jurc": "Jurchen",
+	"kali": "Kayah Li",
+	"kana": "Katakana",
+	"khar": "Kharoshthi",
+	"khmr": "Khmer",
+	"khoj": "Khojki",
+	"kitl": "Khitan large script",
+	"kits": "Khitan small script",
+	"knda": "Kannada",
+	"kore": "Korean (alias for Hangul + Han)",
+	"kpel": "Kpelle",
+	"kthi": "Kaithi",
+	"lana": "Tai Tham (Lanna)",
+	"laoo": "Lao",
+	"latf": "Latin (Fraktur variant)",
+	"latg": "Latin (Gaelic variant)",
+	"latn": "Latin",
+	"leke": "Leke",
+	"lepc": "Lepcha (Róng)",
+	"limb": "Limbu",
+	"lina": "Linear A",
+	"linb": "Linear B",
+	"lisu": "Lisu (Fraser)",
+	"loma": "Loma",
+	"lyci": "Lycian",
+	"lydi": "Lydian",
+	"mahj": "Mahajani",
+	"mand": "Mandaic, Mandaean",
+	"mani": "Manichaean",
+	"marc": "Marchen",
+	"maya": "Mayan hieroglyphs",
+	"mend": "Mende Kikakui",
+	"merc": "Meroitic Cursive",
+	"mero": "Meroitic Hieroglyphs",
+	"mlym": "Malayalam",
+	"modi": "Modi, Moḍī",
+	"mong": "Mongolian",
+	"moon": "Moon (Moon code, Moon script, Moon type)",
+	"mroo": "Mro, Mru",
+	"mtei": "Meitei Mayek (Meithei, Meetei)",
+	"mult": "Multani",
+	"mymr": "Myanmar (Burmese)",
+	"narb": "Old North Arabian (Ancient North Arabian)",
+	"nbat": "Nabataean",
+	"newa": "Newa, Newar, Newari, Nepāla lipi",
+	"nkgb": "Nakhi Geba ('Na-'Khi ²Ggŏ-¹baw, Naxi Geba)",
+	"nkoo": "N’Ko",
+	"nshu": "Nüshu",
+	"ogam": "Ogham",
+	"olck": "Ol Chiki (Ol Cemet’, Ol, Santali)",
+	"orkh": "Old Turkic, Orkhon Runic",
+	"orya": "Oriya",
+	"osge": "Osage",
+	"osma": "Osmanya",
+	"palm": "Palmyrene",
+	"pauc": "Pau Cin Hau",
+	"perm": "Old Permic",
+	"phag": "Phags-pa",
+	"phli": "Inscriptional Pahlavi",
+	"phlp": "Psalter Pahlavi",
+	"phlv": "Book Pahlavi",
+	"phnx": "Phoenician",
+	"piqd": "Klingon (KLI pIqaD)",
+	"plrd": "Miao (Pollard)",
+	"prti": "Inscriptional Parthian",
+	"qaaa": "Reserved for private use (start)",
+	"qabx": "Reserved for private use (end)",
+	"rjng": "Rejang (Redjang, Kaganga)",
+	"roro": "Rongorongo",
+	"runr": "Runic",
+	"samr": "Samaritan",
+	"sara": "Sarati",
+	"sarb": "Old South Arabian",
+	"saur": "Saurashtra",
+	"sgnw": "SignWriting",
+	"shaw": "Shavian (Shaw)",
+	"shrd": "Sharada, Śāradā",
+	"sidd": "Siddham, Siddhaṃ, Siddhamātṛkā",
+	"sind": "Khudawadi, Sindhi",
+	"sinh": "Sinhala",
+	"sora": "Sora Sompeng",
+	"sund": "Sundanese",
+	"sylo": "Syloti Nagri",
+	"syrc": "Syriac",
+	"syre": "Syriac (Estrangelo variant)",
+	"syrj": "Syriac (Western variant)",
+	"syrn": "Syriac (Eastern variant)",
+	"tagb": "Tagbanwa",
+	"takr": "Takri, Ṭākrī, Ṭāṅkrī",
+	"tale": "Tai Le",
+	"talu": "New Tai Lue",
+	"taml": "Tamil",
+	"tang": "Tangut",
+	"tavt": "Tai Viet",
+	"telu": "Telugu",
+	"teng": "Tengwar",
+	"tfng": "Tifinagh (Berber)",
+	"tglg": "Tagalog (Baybayin, Alibata)",
+	"thaa": "Thaana",
+	"thai": "Thai",
+	"tibt": "Tibetan",
+	"tirh": "Tirhuta",
+	"ugar": "Ugaritic",
+	"vaii": "Vai",
+	"visp": "Visible Speech",
+	"wara": "Warang Citi (Varang Kshiti)",
+	"wole": "Woleai",
+	"xpeo": "Old Persian",
+	"xsux": "Cuneiform, Sumero-Akkadian",
+	"yiii": "Yi",
+	"zinh": "Code for inherited script",
+	"zmth": "Mathematical notation",
+	"zsye": "Symbols (Emoji variant)",
+	"zsym": "Symbols",
+	"zxxx": "Code for unwritten documents",
+	"zyyy": "Code for undetermined script",
+	"zzzz": "Code for uncoded script",
+}
+
+// IsValidScript checks if the code is an ISO 15924 script code (example: Latn, Cyrl, Hans).
+//
+// Case insensitive.
+func IsValidScript(code string) bool {
+	_, ok := scriptNames[toLowerASCII(code)]
+	return ok
+}
+
+// ScriptName returns the English name of the ISO 15924 script code (example: "Cyrillic" for Cyrl).
+//
+// Case insensitive. If the code is unknown, it will return empty string.
+func ScriptName(code string) string {
+	return scriptNames[toLowerASCII(code)]
+}
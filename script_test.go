@@ -0,0 +1,39 @@
+package slang_test
+
+import (
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestScriptName(t *testing.T) {
+	tests := map[string]string{
+		"Latn": "Latin",
+		"cyrl": "Cyrillic",
+		"HANS": "Han (Simplified variant)",
+		"Hant": "Han (Traditional variant)",
+		"Arab": "Arabic",
+		"Deva": "Devanagari (Nagari)",
+		"Xxxx": "",
+		"Lat":  "",
+		"":     "",
+	}
+	for code, expected := range tests {
+		if name := slang.ScriptName(code); name != expected {
+			t.Errorf("Error: ScriptName(%s) should be '%s', got '%s'", code, expected, name)
+		}
+		if valid := slang.IsValidScript(code); valid != (expected != "") {
+			t.Errorf("Error: IsValidScript(%s) should be %v", code, expected != "")
+		}
+	}
+
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	for _, lang := range lp.Entries() {
+		if script := lang.Script(); script != "" && script != "Ploc" && !slang.IsValidScript(script) {
+			t.Errorf("Error: Script() of %s should be a valid script, got '%s'", lang.BCP47, script)
+		}
+	}
+}
@@ -10,125 +10,723 @@
 package slang
 
 import (
+	"bufio"
 	"bytes"
-	_ "embed"
+	"context"
 	"encoding/csv"
 	"errors"
+	"fmt"
 	"io"
-	"sort"
+	"iter"
 	"strconv"
 	"strings"
+	"sync"
 )
 
-//go:embed langdb.csv
-var db []byte
+//go:generate go run gen.go
+
+var csvHeader = []string{"id", "name", "location", "lcid", "bcp47", "winid", "iso639_1", "iso639_2", "iso639_3"}
 
 var (
-	ErrParse        = errors.New("error parsing csv database")  // ErrParse is an error when parsing the database.
-	ErrInvalidWinID = errors.New("invalid Windows language ID") // ErrInvalidWindowsID is an error when encountering an invalid Microsoft Windows language ID.
+	ErrParse                 = errors.New("error parsing csv database")     // ErrParse is an error when parsing the database.
+	ErrInvalidWinID          = errors.New("invalid Windows language ID")    // ErrInvalidWindowsID is an error when encountering an invalid Microsoft Windows language ID.
+	ErrInvalidLCID           = errors.New("invalid Microsoft LCID")         // ErrInvalidLCID is an error when encountering an invalid Microsoft LCID.
+	ErrNoMatch               = errors.New("no matching language")           // ErrNoMatch is an error when no supported language matches the request.
+	ErrInvalidAcceptLanguage = errors.New("invalid Accept-Language header") // ErrInvalidAcceptLanguage is an error when parsing a malformed Accept-Language header.
+	ErrInvalidBCP47          = errors.New("invalid BCP47 tag")              // ErrInvalidBCP47 is an error when encountering a structurally malformed BCP47 tag.
+	ErrNotFound              = errors.New("language not found")             // ErrNotFound is an error when no language matches the given value.
+	ErrEmptyInput            = errors.New("empty input")                    // ErrEmptyInput is an error when the given value is empty.
+	ErrInvalidEntry          = errors.New("invalid language entry")         // ErrInvalidEntry is an error when a language in the database is inconsistent.
+	ErrWinIDLength           = errors.New("not 3 characters long")          // ErrWinIDLength is the reason of ErrInvalidWinID when the length is wrong.
+	ErrWinIDNonAlpha         = errors.New("has a non-letter character")     // ErrWinIDNonAlpha is the reason of ErrInvalidWinID when a character is not an ASCII letter.
+	ErrWinIDSentinel         = errors.New("is the ZZZ sentinel")            // ErrWinIDSentinel is the reason of ErrInvalidWinID when it is ZZZ, meaning no Windows language ID.
 )
 
+// ParseError is the error returned when a CSV database is malformed, with the position of the problem.
+//
+// It wraps ErrParse, so errors.Is(err, ErrParse) works.
+type ParseError struct {
+	Line  int    // Line of the CSV where the problem is, starting from 1.
+	Field string // Column of the offending field (example: lcid), or empty string if the whole row is malformed.
+	Value string // Value of the offending field.
+	Err   error  // Underlying error, such as ErrInvalidLCID or a *csv.ParseError.
+}
+
+func (e *ParseError) Error() string {
+	if e.Field != "" {
+		return fmt.Sprintf("%v: line %d: invalid %s %q", ErrParse, e.Line, e.Field, e.Value)
+	}
+	return fmt.Sprintf("%v: line %d: %v", ErrParse, e.Line, e.Err)
+}
+
+func (e *ParseError) Unwrap() []error {
+	return []error{ErrParse, e.Err}
+}
+
 // LangParser is a parser for language database.
+//
+// LangParser is safe for concurrent use by multiple goroutines,
+// including adding custom languages while other goroutines are parsing.
 type LangParser struct {
-	data []Lang
+	mu     sync.RWMutex
+	data   []Lang
+	index  *langIndex
+	policy BestPolicy
+	likely bool
+	retire bool
+	order  []MatchKind
+	cache  *parseCache
+}
+
+// BestPolicy decides which language is the best one when a single-result lookup (FindBy*) finds multiple candidates.
+type BestPolicy int
+
+const (
+	// ShortestTag picks the first candidate.
+	//
+	// For BCP47 lookups this is the best matching tag, for other lookups this is the language with the shortest BCP47 tag.
+	// This is the default policy.
+	ShortestTag BestPolicy = iota
+
+	// HasRegion prefers the first candidate whose BCP47 tag has a region subtag (example: en-US over en).
+	//
+	// If no candidate has a region subtag, it falls back to ShortestTag.
+	HasRegion
+
+	// HasValidWinID prefers the first candidate having a valid Windows language ID.
+	//
+	// If no candidate has a valid Windows language ID, it falls back to ShortestTag.
+	HasValidWinID
+)
+
+// Reserved Microsoft LCIDs, which do not identify a single language.
+//
+// See: https://learn.microsoft.com/en-us/openspecs/windows_protocols/ms-lcid
+const (
+	// NeutralLCID is LOCALE_NEUTRAL, used for entries having no LCID.
+	NeutralLCID uint32 = 0x0000
+
+	// InvariantLCID is LOCALE_INVARIANT, the invariant culture of Windows and .NET.
+	InvariantLCID uint32 = 0x007F
+
+	// CustomUnspecifiedLCID is LOCALE_CUSTOM_UNSPECIFIED, shared by all languages without an assigned LCID.
+	CustomUnspecifiedLCID uint32 = 0x1000
+)
+
+// IsReservedLCID checks if the Microsoft LCID is one of NeutralLCID, InvariantLCID and CustomUnspecifiedLCID.
+func IsReservedLCID(lcid uint32) bool {
+	return lcid == NeutralLCID || lcid == InvariantLCID || lcid == CustomUnspecifiedLCID
 }
 
 // Lang is an entry from the language database.
+//
+// In JSON, MSLCID is encoded as a 4-digit hex string (example: "0x0409").
+//
+// Lang is safe to encode with encoding/gob, as all its fields are exported. If unexported fields are added,
+// Lang will implement GobEncode and GobDecode to keep the encoding compatible.
 type Lang struct {
 	// Displaying name of the language, in ASCII (may contain spaces and special characters)
-	Name string
+	Name string `json:"name"`
 
 	// Location of the language, in ASCII (may contain spaces and special characters)
-	Location string
+	Location string `json:"location"`
 
 	// Microsoft's LCID of the language.
 	//
 	// See: https://learn.microsoft.com/en-us/openspecs/windows_protocols/ms-lcid
-	MSLCID uint32
+	MSLCID uint32 `json:"lcid"`
 
 	// BCP47 tag of the language (example: en-US).
 	// See: https://tools.ietf.org/html/bcp47
-	BCP47 string
+	BCP47 string `json:"bcp47"`
 
 	// Microsoft's Windows language ID of the language (example: CHS).
 	//
 	// See: https://learn.microsoft.com/en-us/dotnet/api/system.globalization.cultureinfo.threeletterwindowslanguagename
-	WinID string
+	WinID string `json:"win_id"`
 
 	// ISO 639-1 code of the language (example: zh).
 	//
 	// If the language does not have an ISO 639-1 code, this field will be same as ISO 639-2.
-	ISO639Set1 string
+	ISO639Set1 string `json:"iso639_1"`
 
 	// ISO 639-2 code of the language (example: zuo).
-	ISO639Set2 string
+	ISO639Set2 string `json:"iso639_2"`
 
 	// ISO 639-3 code of the language (example: cmn).
 	//
 	// For most languages, this field will be same as ISO 639-2.
 	//
 	// If the language is a sub-language of macrolanguage, this field will be different from ISO 639-2.
-	ISO639Set3 string
+	ISO639Set3 string `json:"iso639_3"`
 }
 
-// IsValidWinID checks if the Windows language ID is valid.
+// IsValidWinID checks if the Windows language ID is valid. See ValidateWinID for the reason when it is not.
+//
+// It does not allocate, so it is cheap to be called on every lookup.
 func IsValidWinID(id string) bool {
-	if len(id) != 3 {
-		return false
+	return winIDReason(id) == nil
+}
+
+// ValidateWinID checks if the Windows language ID is valid, which is 3 ASCII letters other than the "ZZZ" sentinel
+// used by languages without a Windows language ID.
+//
+// If it is invalid, it will return an error wrapping both ErrInvalidWinID and the reason:
+// ErrWinIDLength, ErrWinIDNonAlpha or ErrWinIDSentinel.
+func ValidateWinID(id string) error {
+	if reason := winIDReason(id); reason != nil {
+		return fmt.Errorf("%w: %w: %q", ErrInvalidWinID, reason, id)
 	}
-	for _, c := range id {
-		// Check if the character is an ASCII letter.
-		if c < 'A' || (c > 'Z' && c < 'a') || c > 'z' {
-			return false
-		}
+	return nil
+}
+
+// winIDReason returns the reason why the Windows language ID is invalid, or nil if it is valid.
+func winIDReason(id string) error {
+	switch {
+	case len(id) != 3:
+		return ErrWinIDLength
+	case !isASCIIAlpha(id):
+		return ErrWinIDNonAlpha
+	case equalFoldASCII(id, "ZZZ"):
+		return ErrWinIDSentinel
 	}
-	return strings.ToUpper(id) != "ZZZ"
+	return nil
 }
 
 // NewParser creates a default language parser.
+//
+// The embedded database is compiled into the package from langdb.csv by go generate, so it is not parsed at runtime,
+// and the error is always nil. Each parser has its own copy of the languages.
 func NewParser() (*LangParser, error) {
+	data := make([]Lang, len(langDB))
+	copy(data, langDB)
+	return &LangParser{data: data}, nil
+}
+
+// NewParserFromReader creates a language parser from a CSV database instead of the embedded one.
+//
+// The CSV must have the same columns as the embedded database: id, name, location, lcid, bcp47, winid, iso639_1, iso639_2, iso639_3.
+// The header row is optional. If present, columns are mapped by their names in the header,
+// so they can be in any order and unknown columns are ignored; only the id column can be omitted.
+// Without header, columns are mapped by position.
+//
+// A leading UTF-8 byte order mark and spaces around fields are ignored.
+//
+// If the CSV is malformed, it will return a *ParseError wrapping ErrParse, with the line of the problem.
+func NewParserFromReader(r io.Reader) (*LangParser, error) {
+	return NewParserFromReaderContext(context.Background(), r)
+}
+
+// NewParserFromReaderContext is like NewParserFromReader, but stops reading when the context is done,
+// and returns the error of the context.
+//
+// The context is checked between rows, so it bounds the work on large databases or slow readers.
+func NewParserFromReaderContext(ctx context.Context, r io.Reader) (*LangParser, error) {
+	lp, err := parseCSV(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	return &LangParser{data: lp}, nil
+}
+
+// LoadCSV parses additional languages from r and appends them to the parser, like calling AddCustom in a loop.
+//
+// The CSV must have the same columns as the embedded database: id, name, location, lcid, bcp47, winid, iso639_1, iso639_2, iso639_3.
+// The header row is optional. If present, columns are mapped by their names in the header,
+// so they can be in any order and unknown columns are ignored; only the id column can be omitted.
+// Without header, columns are mapped by position.
+//
+// A leading UTF-8 byte order mark and spaces around fields are ignored.
+//
+// If the CSV is malformed, it will return a *ParseError wrapping ErrParse, and the parser is left unchanged.
+func (p *LangParser) LoadCSV(r io.Reader) error {
+	return p.LoadCSVContext(context.Background(), r)
+}
+
+// LoadCSVContext is like LoadCSV, but stops reading when the context is done, and returns the error of the context.
+//
+// The parser is left unchanged if the context is done before all languages are read.
+func (p *LangParser) LoadCSVContext(ctx context.Context, r io.Reader) error {
+	lp, err := parseCSV(ctx, r)
+	if err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, lang := range lp {
+		p.addCustom(lang)
+	}
+	return nil
+}
+
+func parseCSV(ctx context.Context, r io.Reader) ([]Lang, error) {
 	lp := make([]Lang, 0)
-	r := csv.NewReader(bytes.NewReader(db))
+	err := streamCSV(ctx, r, func(lang Lang) error {
+		lp = append(lp, lang)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return lp, nil
+}
+
+// ParseCSVFunc parses languages from r and calls fn for each language in order, without keeping them in memory,
+// such as for importing a large custom database into another storage.
+//
+// The CSV format is the same as NewParserFromReader. If fn returns an error, parsing stops and the error is returned
+// as is. If the CSV is malformed, it will return a *ParseError wrapping ErrParse, after calling fn for the languages
+// before the problem.
+func ParseCSVFunc(r io.Reader, fn func(Lang) error) error {
+	return streamCSV(context.Background(), r, fn)
+}
 
-	for {
-		line, err := r.Read()
+func streamCSV(ctx context.Context, r io.Reader, fn func(Lang) error) error {
+	cr := csv.NewReader(skipBOM(r))
+	cr.FieldsPerRecord = -1
+	cr.ReuseRecord = true
+
+	// columns[i] is the position of csvHeader[i] in a row, or -1 if the column is absent
+	columns := []int{0, 1, 2, 3, 4, 5, 6, 7, 8}
+	for first := true; ; first = false {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		line, err := cr.Read()
 		if err == io.EOF {
 			break
 		}
 		if err != nil {
-			return nil, ErrParse
+			var csvErr *csv.ParseError
+			if errors.As(err, &csvErr) {
+				return &ParseError{Line: csvErr.Line, Err: err}
+			}
+			return &ParseError{Err: err}
 		}
-		if len(line) != 9 {
-			return nil, ErrParse
+		row, _ := cr.FieldPos(0)
+		for i := range line {
+			line[i] = strings.TrimSpace(line[i])
 		}
 
-		fID, fMSLCID := line[0], line[3]
-		if fID == "id" {
+		if first && isCSVHeader(line) {
+			if columns, err = headerColumns(line); err != nil {
+				return &ParseError{Line: row, Err: err}
+			}
+			cr.FieldsPerRecord = len(line)
 			continue
 		}
+		if cr.FieldsPerRecord <= 0 && len(line) != len(csvHeader) {
+			return &ParseError{Line: row, Err: fmt.Errorf("expected %d fields, got %d", len(csvHeader), len(line))}
+		}
+		field := func(i int) string {
+			if columns[i] < 0 {
+				return ""
+			}
+			return line[columns[i]]
+		}
 
+		fMSLCID := field(3)
+		if len(fMSLCID) < 2 || strings.ToLower(fMSLCID[:2]) != "0x" {
+			return &ParseError{Line: row, Field: "lcid", Value: fMSLCID, Err: ErrInvalidLCID}
+		}
 		mslcid, err := strconv.ParseUint(fMSLCID[2:], 16, 32)
 		if err != nil {
-			return nil, ErrParse
+			return &ParseError{Line: row, Field: "lcid", Value: fMSLCID, Err: ErrInvalidLCID}
 		}
 
-		lp = append(lp, Lang{
-			Name:       line[1],
-			Location:   line[2],
+		err = fn(Lang{
+			Name:       field(1),
+			Location:   field(2),
 			MSLCID:     uint32(mslcid),
-			BCP47:      line[4],
-			WinID:      line[5],
-			ISO639Set1: line[6],
-			ISO639Set2: line[7],
-			ISO639Set3: line[8],
+			BCP47:      field(4),
+			WinID:      field(5),
+			ISO639Set1: field(6),
+			ISO639Set2: field(7),
+			ISO639Set3: field(8),
 		})
+		if err != nil {
+			return err
+		}
 	}
-	return &LangParser{data: lp}, nil
+	return nil
+}
+
+// isCSVHeader reports whether the row is a header row, which has an "id" or "bcp47" column.
+func isCSVHeader(line []string) bool {
+	for _, name := range line {
+		if equalFoldASCII(name, "id") || equalFoldASCII(name, "bcp47") {
+			return true
+		}
+	}
+	return false
+}
+
+// headerColumns maps the columns of csvHeader to their positions in the header row, case insensitive.
+//
+// Unknown columns are ignored. All columns except "id" are required.
+func headerColumns(header []string) ([]int, error) {
+	columns := make([]int, len(csvHeader))
+	for i, name := range csvHeader {
+		columns[i] = -1
+		for pos := range header {
+			if equalFoldASCII(header[pos], name) {
+				columns[i] = pos
+				break
+			}
+		}
+		if columns[i] < 0 && name != "id" {
+			return nil, fmt.Errorf("missing column %q", name)
+		}
+	}
+	return columns, nil
+}
+
+// skipBOM returns a reader of r without the leading UTF-8 byte order mark, if any.
+func skipBOM(r io.Reader) io.Reader {
+	br := bufio.NewReader(r)
+	if bom, err := br.Peek(3); err == nil && bytes.Equal(bom, []byte("\xEF\xBB\xBF")) {
+		br.Discard(len(bom))
+	}
+	return br
+}
+
+// WriteCSV writes all languages known by the parser (including custom ones) to w as CSV, in database order.
+//
+// The output has the same columns as the embedded database, starting with a header row:
+// id, name, location, lcid, bcp47, winid, iso639_1, iso639_2, iso639_3.
+// MSLCID is formatted as a 4-digit hex number (example: 0x0409), and id is the 1-based row number.
+//
+// The output can be read back by NewParserFromReader or LoadCSV.
+func (p *LangParser) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+
+	id := 0
+	for lang := range p.All() {
+		id++
+		err := cw.Write([]string{
+			strconv.Itoa(id),
+			lang.Name,
+			lang.Location,
+			formatLCID(lang.MSLCID),
+			lang.BCP47,
+			lang.WinID,
+			lang.ISO639Set1,
+			lang.ISO639Set2,
+			lang.ISO639Set3,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
 }
 
 // AddCustom adds custom language to the parser.
 func (p *LangParser) AddCustom(lang Lang) *LangParser {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.addCustom(lang)
+	return p
+}
+
+// addCustom must be called with p.mu held.
+func (p *LangParser) addCustom(lang Lang) {
 	p.data = append(p.data, lang)
+	p.invalidate()
+	if p.index != nil {
+		p.index.add(len(p.data)-1, lang)
+	}
+}
+
+// RemoveByBCP47 removes all languages whose BCP47 tag equals to the given tag from the parser,
+// and returns the number of languages removed.
+//
+// Case insensitive, support both dash (-) and underscore (_) as separator.
+// The order of the remaining languages is preserved.
+func (p *LangParser) RemoveByBCP47(tag string) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	tag = stdBCP47Tag(tag)
+	data := make([]Lang, 0, len(p.data))
+	for _, lang := range p.data {
+		if stdBCP47Tag(lang.BCP47) != tag {
+			data = append(data, lang)
+		}
+	}
+	removed := len(p.data) - len(data)
+	if removed > 0 {
+		p.setData(data)
+	}
+	return removed
+}
+
+// Upsert replaces the language having the same BCP47 tag (case insensitive) with the given language,
+// or adds it to the parser if there is none.
+//
+// If multiple languages have the same BCP47 tag, they are all replaced by the given language,
+// at the position of the first one.
+func (p *LangParser) Upsert(lang Lang) *LangParser {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	tag := stdBCP47Tag(lang.BCP47)
+	data := make([]Lang, 0, len(p.data)+1)
+	found := false
+	for _, existing := range p.data {
+		switch {
+		case stdBCP47Tag(existing.BCP47) != tag:
+			data = append(data, existing)
+		case !found:
+			data = append(data, lang)
+			found = true
+		}
+	}
+	if !found {
+		p.addCustom(lang)
+		return p
+	}
+	p.setData(data)
+	return p
+}
+
+// setData replaces all languages of the parser, and rebuilds the index if there is one.
+//
+// The existing data slice is never modified in place, so copies taken before stay unchanged.
+// setData must be called with p.mu held.
+func (p *LangParser) setData(data []Lang) {
+	p.data = data
+	p.invalidate()
+	if p.index != nil {
+		p.index = newLangIndex(p.data)
+	}
+}
+
+// Deduplicate removes exact duplicated languages (all fields are equal) from the parser,
+// and returns the number of languages removed.
+//
+// The first occurrence of each language is kept, and the order of the remaining languages is preserved.
+// It is useful after loading custom languages with LoadCSV or AddCustom.
+func (p *LangParser) Deduplicate() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	data := uniqueLangs(append([]Lang(nil), p.data...))
+	removed := len(p.data) - len(data)
+	if removed > 0 {
+		p.setData(data)
+	}
+	return removed
+}
+
+// Entries returns a copy of all languages known by the parser, in database order.
+//
+// Modifying the returned slice does not affect the parser.
+func (p *LangParser) Entries() []Lang {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	entries := make([]Lang, len(p.data))
+	copy(entries, p.data)
+	return entries
+}
+
+// All returns an iterator over all languages known by the parser, in database order.
+//
+// Unlike Entries, it does not copy the whole database. The iterator runs over a snapshot taken when the
+// iteration starts, so languages added or removed during the iteration are not visited.
+func (p *LangParser) All() iter.Seq[Lang] {
+	return func(yield func(Lang) bool) {
+		p.mu.RLock()
+		data := p.data
+		p.mu.RUnlock()
+
+		for _, lang := range data {
+			if !yield(lang) {
+				return
+			}
+		}
+	}
+}
+
+// Filter returns an iterator over all languages matching the predicate, in database order. See All for details.
+func (p *LangParser) Filter(pred func(Lang) bool) iter.Seq[Lang] {
+	return func(yield func(Lang) bool) {
+		for lang := range p.All() {
+			if pred(lang) && !yield(lang) {
+				return
+			}
+		}
+	}
+}
+
+// Where returns all languages matching the predicate.
+//
+// Result is in database order and is not sorted, so callers can apply their own ordering.
+func (p *LangParser) Where(pred func(Lang) bool) []Lang {
+	results := []Lang{}
+	for lang := range p.Filter(pred) {
+		results = append(results, lang)
+	}
+	return results
+}
+
+// LanguagesWithoutWinID returns all languages without a valid Windows language ID, such as the ones with the "ZZZ" sentinel
+// (example: Afar). It is useful to report the coverage gaps between ISO 639 and Microsoft data.
+//
+// Result is in database order.
+func (p *LangParser) LanguagesWithoutWinID() []Lang {
+	return p.Where(func(lang Lang) bool { return !IsValidWinID(lang.WinID) })
+}
+
+// LanguagesWithoutLCID returns all languages whose Microsoft LCID is NeutralLCID (0x0000).
+//
+// The embedded database uses CustomUnspecifiedLCID (0x1000) instead for languages without an assigned LCID,
+// see LanguagesWithUnspecifiedLCID for them.
+//
+// Result is in database order.
+func (p *LangParser) LanguagesWithoutLCID() []Lang {
+	return p.Where(func(lang Lang) bool { return lang.MSLCID == NeutralLCID })
+}
+
+// LanguagesWithUnspecifiedLCID returns all languages whose Microsoft LCID is CustomUnspecifiedLCID (0x1000),
+// which Windows uses for locales without an assigned LCID (example: Afar).
+//
+// Result is in database order.
+func (p *LangParser) LanguagesWithUnspecifiedLCID() []Lang {
+	return p.Where(func(lang Lang) bool { return lang.MSLCID == CustomUnspecifiedLCID })
+}
+
+// GroupByLanguage returns all languages grouped by the primary language subtag of their BCP47 tag, in lower case.
+//
+// Each group is sorted by BCP47 tag length, so "zh" will map to [zh zh-CN zh-HK zh-MO zh-SG zh-TW ...].
+func (p *LangParser) GroupByLanguage() map[string][]Lang {
+	groups := map[string][]Lang{}
+	for lang := range p.All() {
+		language := strings.SplitN(stdBCP47Tag(lang.BCP47), "-", 2)[0]
+		groups[language] = append(groups[language], lang)
+	}
+	for _, group := range groups {
+		sortByBCP47Tag(group)
+	}
+	return groups
+}
+
+// Select maps each language of langs to a value, preserving the order (example: collect BCP47 tags of the results of Where).
+func Select[T any](langs []Lang, fn func(Lang) T) []T {
+	results := make([]T, 0, len(langs))
+	for _, lang := range langs {
+		results = append(results, fn(lang))
+	}
+	return results
+}
+
+// Len returns the number of languages known by the parser.
+func (p *LangParser) Len() int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return len(p.data)
+}
+
+// IsEmpty reports whether the parser knows no language.
+func (p *LangParser) IsEmpty() bool {
+	return p.Len() == 0
+}
+
+// Stats is a summary of the languages known by a parser, see LangParser.Stats.
+type Stats struct {
+	// Total number of languages, same as Len.
+	Total int
+
+	// Number of distinct languages by the primary language subtag of BCP47 (example: en-US and en-GB count as one).
+	Languages int
+
+	// Number of distinct region subtags of BCP47 (example: US, IN or 419).
+	Regions int
+
+	// Number of languages having a valid Windows language ID.
+	ValidWinIDs int
+}
+
+// Stats returns a summary of the languages known by the parser, in a single pass.
+//
+// It is cheap enough to be logged at startup as a health check of the database.
+func (p *LangParser) Stats() Stats {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	stats := Stats{Total: len(p.data)}
+	languages := map[string]bool{}
+	regions := map[string]bool{}
+	for i := range p.data {
+		parts := splitTag(p.data[i].BCP47)
+		languages[parts.language] = true
+		if parts.region != "" {
+			regions[parts.region] = true
+		}
+		if p.data[i].IsValidWinID() {
+			stats.ValidWinIDs++
+		}
+	}
+	stats.Languages, stats.Regions = len(languages), len(regions)
+	return stats
+}
+
+// Clone returns a deep copy of the parser, including custom languages and settings.
+//
+// Languages added to the clone do not affect the original parser, and vice versa.
+// Cloning a shared parser and then calling AddCustom on the clone is the recommended pattern
+// for per-request or per-tenant customization.
+//
+// The cache enabled by WithCache is not shared, the clone starts with an empty cache of the same size.
+func (p *LangParser) Clone() *LangParser {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	clone := &LangParser{data: make([]Lang, len(p.data)), policy: p.policy, likely: p.likely, retire: p.retire, order: p.order}
+	copy(clone.data, p.data)
+	if p.index != nil {
+		clone.index = newLangIndex(clone.data)
+	}
+	if p.cache != nil {
+		clone.cache = newParseCache(p.cache.size)
+	}
+	return clone
+}
+
+// WithLikelySubtags makes FindAllByBCP47 also match the tag expanded with likely subtags (example: zh-CN as zh-Hans-CN).
+//
+// It is disabled by default. See AddLikelySubtags for the expansion.
+func (p *LangParser) WithLikelySubtags() *LangParser {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.likely = true
+	p.invalidate()
+	return p
+}
+
+// WithRetirements makes FindAllByISOCode follow retired ISO 639 codes having a single replacement
+// when the code itself is not found (example: "drh" will find "khk").
+//
+// It is disabled by default. See Retirements for the retired codes.
+func (p *LangParser) WithRetirements() *LangParser {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.retire = true
+	p.invalidate()
+	return p
+}
+
+// WithBestPolicy sets the policy used by the FindBy* methods to pick the best language from multiple candidates.
+func (p *LangParser) WithBestPolicy(policy BestPolicy) *LangParser {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.policy = policy
+	p.invalidate()
 	return p
 }
 
@@ -142,28 +740,114 @@ func (p *LangParser) AddCustom(lang Lang) *LangParser {
 //  3. "bho-Deva-IN" will return [bho-Deva-IN bho-Deva bho] (best match goes first).
 //  4. "be" will return [be be-BY] but no "bem" or "bem-ZM".
 //  5. "en-Invalid" will return [en] but no "en-Invalid".
+//  6. "en-US-x-custom" will return [en-US en], as extension and private use subtags are ignored.
+//  7. "*" will return all languages sorted by BCP47 tag length, as the wildcard of Accept-Language matches any language.
+//  8. "en-*" will return the same as "en", and "en-*-US" the same as "en-US", as wildcard subtags after the language are ignored.
+//  9. "*-US" will return an empty slice, as the wildcard is only supported as the whole tag or after the language.
+//
+// If the parser is created with WithLikelySubtags, the matches of the tag expanded with likely subtags
+// are appended, so "zh-CN" will also return "zh-Hans", and "zh-TW" will also return "zh-Hant".
 func (p *LangParser) FindAllByBCP47(bcp47 string) []Lang {
-	results := []Lang{}
-	tagSlices := strings.Split(stdBCP47Tag(bcp47), "-")
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	results := p.findAllByBCP47(bcp47)
+	if p.likely && trimExtensions(bcp47) != "*" {
+		results = uniqueLangs(append(results, p.findAllByBCP47(AddLikelySubtags(bcp47))...))
+	}
+	return results
+}
+
+// Match is a language found by FindAllByBCP47Scored, with its distance from the tag looked up.
+type Match struct {
+	Lang
+
+	// Distance is the number of subtags between the tag looked up and the BCP47 tag of the language.
+	//
+	// It is 0 for the exact tag, positive for parent tags (example: 1 for "bho-Deva" when looking up "bho-Deva-IN"),
+	// and negative for other tags, such as descendants (example: -1 for "bho-Deva-IN" when looking up "bho-Deva").
+	Distance int
+}
+
+// FindAllByBCP47Scored is like FindAllByBCP47, but also returns the distance of each language from the tag,
+// so callers can rank the results in their own way. The results are in the same order as FindAllByBCP47.
+//
+// # Examples
+//  1. "bho-Deva-IN" will return [bho-Deva-IN:0 bho-Deva:1 bho:2].
+//  2. "bho-Deva" will return [bho-Deva:0 bho:1 bho-Deva-IN:-1].
+//
+// Extension and private use subtags of the tag are ignored. For other tags, the distance is the number of subtags
+// of the language after the subtags shared with the tag, negated (example: -1 for "zh-Hans" when looking up "zh-CN").
+func (p *LangParser) FindAllByBCP47Scored(bcp47 string) []Match {
+	query := strings.Split(trimWildcards(trimExtensions(bcp47)), "-")
+	langs := p.FindAllByBCP47(bcp47)
+	matches := make([]Match, 0, len(langs))
+	for _, lang := range langs {
+		subtags := strings.Split(stdBCP47Tag(lang.BCP47), "-")
+		common := 0
+		for common < len(query) && common < len(subtags) && query[common] == subtags[common] {
+			common++
+		}
+		distance := len(query) - len(subtags)
+		if common < len(subtags) {
+			distance = common - len(subtags)
+		}
+		matches = append(matches, Match{Lang: lang, Distance: distance})
+	}
+	return matches
+}
+
+// findAllByBCP47 must be called with p.mu held.
+func (p *LangParser) findAllByBCP47(bcp47 string) []Lang {
+	base := trimExtensions(bcp47)
+	if base == "*" {
+		results := append([]Lang(nil), p.data...)
+		sortByBCP47Tag(results)
+		return uniqueLangs(results)
+	}
+	base = trimWildcards(base)
+	if base == "" {
+		return []Lang{}
+	}
+	tagSlices := strings.Split(base, "-")
+
+	// Most tags match a few languages, but base languages (example: "en") match all their regional variants.
+	capacity := 8
+	if p.index != nil {
+		capacity = len(p.index.descendants[base])
+		for pos := range tagSlices {
+			capacity += len(p.index.fields[fieldBCP47][strings.Join(tagSlices[:pos+1], "-")])
+		}
+	}
+	results := make([]Lang, 0, capacity)
 
 	// Find up
 	for pos := range tagSlices {
 		tag := strings.Join(tagSlices[:len(tagSlices)-pos], "-")
+		if p.index != nil {
+			results = appendAt(results, p.data, p.index.fields[fieldBCP47][tag])
+			continue
+		}
 		for _, lang := range p.data {
-			if strings.EqualFold(lang.BCP47, tag) {
+			if equalFoldASCII(lang.BCP47, tag) {
 				results = append(results, lang)
 			}
 		}
 	}
 
 	// Find down
-	for _, lang := range p.data {
-		if strings.HasPrefix(strings.ToLower(lang.BCP47), stdBCP47Tag(bcp47)+"-") {
-			results = append(results, lang)
+	if p.index != nil {
+		results = appendAt(results, p.data, p.index.descendants[base])
+	} else {
+		prefix := base + "-"
+		for _, lang := range p.data {
+			if hasPrefixFoldASCII(lang.BCP47, prefix) {
+				results = append(results, lang)
+			}
 		}
 	}
 
-	return results
+	return uniqueLangs(results)
 }
 
 // FindAllByWinID returns all possible values matching the Windows language ID.
@@ -176,36 +860,133 @@ func (p *LangParser) FindAllByWinID(winID string) []Lang {
 		return []Lang{}
 	}
 
-	return p.selectEqualFold(winID, func(lang Lang) string {
-		return lang.WinID
-	})
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.selectEqualFold(winID, fieldWinID)
+}
+
+// FindAllByWinIDPrefix returns all languages whose Windows language ID starts with the given 1 to 3 letters
+// (example: "EN" will return the languages of ENU, ENG, ENA and more).
+//
+// Case insensitive. Result is sorted by BCP47 tag length.
+//
+// Prefix matching is a best-effort way to reconcile legacy data using shortened or inconsistent Windows language IDs,
+// and may return unrelated languages sharing the prefix. Use FindAllByWinID for the exact lookup.
+// Languages without a Windows language ID ("ZZZ") are never matched.
+//
+// If the prefix is empty, longer than 3 letters or has a non-letter character, it will return an empty slice.
+func (p *LangParser) FindAllByWinIDPrefix(prefix string) []Lang {
+	results := []Lang{}
+	if len(prefix) < 1 || len(prefix) > 3 || !isASCIIAlpha(prefix) {
+		return results
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	for _, lang := range p.data {
+		if IsValidWinID(lang.WinID) && hasPrefixFoldASCII(lang.WinID, prefix) {
+			results = append(results, lang)
+		}
+	}
+	sortByBCP47Tag(results)
+	return uniqueLangs(results)
 }
 
 // FindAllByISO639Set1 returns all possible values matching the ISO 639-1 code.
 //
 // Case insensitive. Result is sorted by BCP47 tag length.
 func (p *LangParser) FindAllByISO639Set1(iso639 string) []Lang {
-	return p.selectEqualFold(iso639, func(lang Lang) string {
-		return lang.ISO639Set1
-	})
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.selectEqualFold(iso639, fieldISO639Set1)
 }
 
 // FindAllByISO639Set2 returns all possible values matching the ISO 639-2 code.
 //
 // Case insensitive. Result is sorted by BCP47 tag length.
 func (p *LangParser) FindAllByISO639Set2(iso639 string) []Lang {
-	return p.selectEqualFold(iso639, func(lang Lang) string {
-		return lang.ISO639Set2
-	})
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.selectEqualFold(iso639, fieldISO639Set2)
 }
 
 // FindAllByISO639Set3 returns all possible values matching the ISO 639-3 code.
 //
 // Case insensitive. Result is sorted by BCP47 tag length.
 func (p *LangParser) FindAllByISO639Set3(iso639 string) []Lang {
-	return p.selectEqualFold(iso639, func(lang Lang) string {
-		return lang.ISO639Set3
-	})
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.selectEqualFold(iso639, fieldISO639Set3)
+}
+
+// FindAllByLocation returns all languages whose location equals to the given location (example: France).
+//
+// Case insensitive. Result is sorted by BCP47 tag length.
+func (p *LangParser) FindAllByLocation(location string) []Lang {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.selectEqualFold(location, fieldLocation)
+}
+
+// SearchByLocation returns all languages whose location contains the given substring (example: "Congo").
+//
+// Case insensitive. Result is sorted by BCP47 tag length.
+//
+// If the substring is empty, it will return an empty slice.
+func (p *LangParser) SearchByLocation(substr string) []Lang {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	results := []Lang{}
+	if substr == "" {
+		return results
+	}
+	substr = toLowerASCII(substr)
+	for _, lang := range p.data {
+		if strings.Contains(toLowerASCII(lang.Location), substr) {
+			results = append(results, lang)
+		}
+	}
+	sortByBCP47Tag(results)
+	return uniqueLangs(results)
+}
+
+// FindAllByMSLCID returns all possible values matching the Microsoft LCID (example: 0x0409).
+//
+// Result is sorted by BCP47 tag length.
+func (p *LangParser) FindAllByMSLCID(lcid uint32) []Lang {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	results := []Lang{}
+	if p.index != nil {
+		results = appendAt(results, p.data, p.index.lcid[lcid])
+	} else {
+		for _, lang := range p.data {
+			if lang.MSLCID == lcid {
+				results = append(results, lang)
+			}
+		}
+	}
+	sortByBCP47Tag(results)
+	return uniqueLangs(results)
+}
+
+// TagsForLCID returns all BCP47 tags of the languages matching the Microsoft LCID, without duplicates.
+//
+// Result is sorted by BCP47 tag length (example: 0x7804 returns ["zh"]).
+//
+// If no value is found, it will return an empty slice.
+func (p *LangParser) TagsForLCID(lcid uint32) []string {
+	tags := []string{}
+	seen := map[string]bool{}
+	for _, lang := range p.FindAllByMSLCID(lcid) {
+		if !seen[lang.BCP47] {
+			seen[lang.BCP47] = true
+			tags = append(tags, lang.BCP47)
+		}
+	}
+	return tags
 }
 
 // FindAllByISO639Alpah3 returns all possible values matching the given ISO 639 code.
@@ -214,7 +995,11 @@ func (p *LangParser) FindAllByISO639Set3(iso639 string) []Lang {
 //
 // This function will try to find the language by ISO 639-3, then ISO 639-2, and finally ISO 639-1.
 // If any found in the previous step, it will skip the next step.
+//
+// Deprecated codes are normalized by NormalizeISOCode before the lookup (example: "iw" will find Hebrew).
+// If the parser is created with WithRetirements, retired codes with a single replacement are followed.
 func (p *LangParser) FindAllByISOCode(iso639 string) []Lang {
+	iso639 = NormalizeISOCode(iso639)
 	results := p.FindAllByISO639Set3(iso639)
 	if len(results) == 0 {
 		results = p.FindAllByISO639Set2(iso639)
@@ -222,6 +1007,13 @@ func (p *LangParser) FindAllByISOCode(iso639 string) []Lang {
 	if len(results) == 0 {
 		results = p.FindAllByISO639Set1(iso639)
 	}
+
+	p.mu.RLock()
+	retire := p.retire
+	p.mu.RUnlock()
+	if replacement, _, ok := Retirements(iso639); len(results) == 0 && retire && ok && replacement != "" {
+		return p.FindAllByISOCode(replacement)
+	}
 	return results
 }
 
@@ -229,31 +1021,53 @@ func (p *LangParser) FindAllByISOCode(iso639 string) []Lang {
 //
 // Case insensitive, support both dash (-) and underscore (_) as separator.
 //
+// The candidates are picked by the BestPolicy of the parser, which is the best matching tag by default.
+//
+// The wildcard "*" matches any language rather than a single one, so it will return nil (see FindAllByBCP47).
+//
 // If no value is found, it will return nil.
 func (p *LangParser) FindByBCP47(bcp47 string) *Lang {
-	return firstOrNil(p.FindAllByBCP47(bcp47))
+	if trimExtensions(bcp47) == "*" {
+		return nil
+	}
+	return p.pickBest(p.FindAllByBCP47(bcp47))
 }
 
 // FindByWinID returns the first possible best value matching the Windows language ID.
 //
 // Case insensitive.
 //
-// If there is multiple possible languages found, it will return the language with the shortest BCP47 tag.
+// If there is multiple possible languages found, it will return the language picked by the BestPolicy of the parser,
+// which is the language with the shortest BCP47 tag by default.
 //
 // If no value is found or the provided Windows language ID is invalid, it will return nil.
 func (p *LangParser) FindByWinID(winID string) *Lang {
-	return firstOrNil(p.FindAllByWinID(winID))
+	return p.pickBest(p.FindAllByWinID(winID))
 }
 
-func (p *LangParser) selectEqualFold(value string, fieldGetter func(lang Lang) string) []Lang {
-	results := []Lang{}
-	for _, lang := range p.data {
-		if strings.EqualFold(fieldGetter(lang), value) {
-			results = append(results, lang)
+// selectEqualFold must be called with p.mu held.
+func (p *LangParser) selectEqualFold(value string, field langField) []Lang {
+	var results []Lang
+	if p.index != nil {
+		positions := p.index.fields[field][toLowerASCII(value)]
+		results = appendAt(make([]Lang, 0, len(positions)), p.data, positions)
+	} else {
+		// Count first, so the results are allocated once even for languages with many regional variants.
+		count := 0
+		for _, lang := range p.data {
+			if equalFoldASCII(field.of(lang), value) {
+				count++
+			}
+		}
+		results = make([]Lang, 0, count)
+		for _, lang := range p.data {
+			if equalFoldASCII(field.of(lang), value) {
+				results = append(results, lang)
+			}
 		}
 	}
 	sortByBCP47Tag(results)
-	return results
+	return uniqueLangs(results)
 }
 
 // IsValidWinID checks if the Windows language ID is valid.
@@ -261,81 +1075,330 @@ func (lang *Lang) IsValidWinID() bool {
 	return IsValidWinID(lang.WinID)
 }
 
+// String returns a human readable form of the language, in format of "Name (Location) [BCP47]".
+//
+// If the location is empty, it will be omitted (example: "French [fr]").
+//
+// The format is stable and safe to be used in logs.
+func (lang Lang) String() string {
+	if lang.Location == "" {
+		return fmt.Sprintf("%s [%s]", lang.Name, lang.BCP47)
+	}
+	return fmt.Sprintf("%s (%s) [%s]", lang.Name, lang.Location, lang.BCP47)
+}
+
+// Equal reports whether two languages are the same entry.
+//
+// It is a full value comparison of all fields, including MSLCID, and is case sensitive.
+// It is the same as comparing with ==.
+func (lang Lang) Equal(other Lang) bool {
+	return lang == other
+}
+
+// SameLanguage reports whether two languages share the same primary language,
+// regardless of script, region and other fields (example: en-US and en-GB).
+//
+// Case insensitive. The primary language subtags of the BCP47 tags are compared.
+// If either BCP47 tag is empty, the ISO 639-3 codes are compared instead.
+// Two languages with both fields empty are never the same language.
+func (lang Lang) SameLanguage(other Lang) bool {
+	if lang.BCP47 != "" && other.BCP47 != "" {
+		return equalFoldASCII(splitTag(lang.BCP47).language, splitTag(other.BCP47).language)
+	}
+	return lang.ISO639Set3 != "" && equalFoldASCII(lang.ISO639Set3, other.ISO639Set3)
+}
+
+// Key returns a stable and readable key of the language, in format of "BCP47/ISO639Set3",
+// with the BCP47 tag in canonical casing and the ISO 639-3 code in lower case (example: "zh-Hant-TW/zho").
+//
+// Sub-languages sharing the BCP47 tag of their macrolanguage have different keys (example: "zh/zho" and "zh/cmn").
+// Languages differing only in other fields, such as name or MSLCID, share the same key; Validate reports them
+// as duplicates. Use Lang itself as the map key if a full value comparison is needed.
+func (lang Lang) Key() string {
+	return CanonicalizeBCP47(lang.BCP47) + "/" + toLowerASCII(lang.ISO639Set3)
+}
+
+// TabString returns all fields of the language delimited by tab (\t).
+//
+// The fields are in the same order as the columns of the language database (without id):
+// name, location, lcid, bcp47, winid, iso639_1, iso639_2, iso639_3.
+// MSLCID is formatted as a 4-digit hex number (example: 0x0409).
+func (lang Lang) TabString() string {
+	return strings.Join([]string{
+		lang.Name,
+		lang.Location,
+		formatLCID(lang.MSLCID),
+		lang.BCP47,
+		lang.WinID,
+		lang.ISO639Set1,
+		lang.ISO639Set2,
+		lang.ISO639Set3,
+	}, "\t")
+}
+
 // FindByISO639Set1 returns the first possible best value matching the ISO 639-1 code.
 //
 // Case insensitive.
 //
-// If there is multiple possible languages found, it will return the language with the shortest BCP47 tag.
+// If there is multiple possible languages found, it will return the language picked by the BestPolicy of the parser,
+// which is the language with the shortest BCP47 tag by default.
 //
 // If no value is found, it will return nil.
 func (p *LangParser) FindByISO639Set1(iso639 string) *Lang {
-	return firstOrNil(p.FindAllByISO639Set1(iso639))
+	return p.pickBest(p.FindAllByISO639Set1(iso639))
 }
 
 // FindByISO639Set2 returns the first possible best value matching the ISO 639-2 code.
 //
 // Case insensitive.
 //
-// If there is multiple possible languages found, it will return the language with the shortest BCP47 tag.
+// If there is multiple possible languages found, it will return the language picked by the BestPolicy of the parser,
+// which is the language with the shortest BCP47 tag by default.
 //
 // If no value is found, it will return nil.
 func (p *LangParser) FindByISO639Set2(iso639 string) *Lang {
-	return firstOrNil(p.FindAllByISO639Set2(iso639))
+	return p.pickBest(p.FindAllByISO639Set2(iso639))
 }
 
 // FindByISO639Set3 returns the first possible best value matching the ISO 639-3 code.
 //
 // Case insensitive.
 //
-// If there is multiple possible languages found, it will return the language with the shortest BCP47 tag.
+// If there is multiple possible languages found, it will return the language picked by the BestPolicy of the parser,
+// which is the language with the shortest BCP47 tag by default.
 //
 // If no value is found, it will return nil.
 func (p *LangParser) FindByISO639Set3(iso639 string) *Lang {
-	return firstOrNil(p.FindAllByISO639Set3(iso639))
+	return p.pickBest(p.FindAllByISO639Set3(iso639))
 }
 
 // FindByISOCode returns the first possible best value matching the given ISO 639 code.
 //
 // Case insensitive.
 //
-// If there is multiple possible languages found, it will return the language with the shortest BCP47 tag.
+// If there is multiple possible languages found, it will return the language picked by the BestPolicy of the parser,
+// which is the language with the shortest BCP47 tag by default.
 //
 // If no value is found, it will return nil.
 //
 // This function will try to find the language by order of ISO 639-3, then ISO 639-2, and finally ISO 639-1.
+// Deprecated codes are normalized by NormalizeISOCode before the lookup.
 func (p *LangParser) FindByISOCode(iso639 string) *Lang {
-	return firstOrNil(p.FindAllByISOCode(iso639))
+	return p.pickBest(p.FindAllByISOCode(iso639))
+}
+
+// FindByMSLCID returns the first possible best value matching the Microsoft LCID (example: 0x0409).
+//
+// If there is multiple possible languages found, it will return the language picked by the BestPolicy of the parser,
+// which is the language with the shortest BCP47 tag by default.
+//
+// If no value is found, it will return nil.
+//
+// Reserved LCIDs (see IsReservedLCID) are shared by unrelated languages, so it always returns nil for them,
+// instead of an arbitrary match. Use FindAllByMSLCID to list the languages sharing a reserved LCID.
+func (p *LangParser) FindByMSLCID(lcid uint32) *Lang {
+	if IsReservedLCID(lcid) {
+		return nil
+	}
+	return p.pickBest(p.FindAllByMSLCID(lcid))
 }
 
 // Parse tries to parse the language code and return the best possible language.
 //
-// This function will try to match in following order: BCP47, Windows language ID, ISO 639-3, ISO 639-2, ISO 639-1.
+// This function will try to match in following order: BCP47, ISO 639-3, ISO 639-2, ISO 639-1, Windows language ID.
+// The order can be changed by WithParseOrder.
+//
+// Grandfathered tags of RFC 5646 are parsed as their preferred values before the matching:
 //
-// If the language code is not found, it will return nil.
+//	en-GB-oed  -> en-GB-oxendict    i-ami      -> ami    i-bnn      -> bnn    i-hak      -> hak
+//	i-klingon  -> tlh               i-lux      -> lb     i-navajo   -> nv     i-pwn      -> pwn
+//	i-tao      -> tao               i-tay      -> tay    i-tsu      -> tsu    sgn-BE-FR  -> sfb
+//	sgn-BE-NL  -> vgt               sgn-CH-DE  -> sgg    art-lojban -> jbo    no-bok     -> nb
+//	no-nyn     -> nn                zh-guoyu   -> cmn    zh-hakka   -> hak    zh-min-nan -> nan
+//	zh-xiang   -> hsn
+//
+// If the language code is not found, it will return nil. Use ParseE to know the reason.
 func (p *LangParser) Parse(value string) *Lang {
-	if lang := p.FindByBCP47(value); lang != nil {
-		return lang
+	lang, _ := p.ParseE(value)
+	return lang
+}
+
+// ParseE is like Parse, but returns an error instead of nil when the language cannot be parsed.
+//
+// If the value is empty or only contains spaces, it will return ErrEmptyInput.
+// If the language code is not found, it will return ErrNotFound.
+// Returned errors can be checked by errors.Is.
+func (p *LangParser) ParseE(value string) (*Lang, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, ErrEmptyInput
 	}
-	if lang := p.FindByISOCode(value); lang != nil {
-		return lang
+	if lang, _ := p.ParseWithMatch(value); lang != nil {
+		return lang, nil
 	}
-	if lang := p.FindByWinID(value); lang != nil {
-		return lang
+	return nil, fmt.Errorf("%w: %q", ErrNotFound, value)
+}
+
+// ParseMany parses each of the language codes by Parse, and returns the languages in the same order.
+//
+// If a language code is not found, its language is nil. Duplicated codes are parsed only once,
+// and share the same returned language.
+func (p *LangParser) ParseMany(codes []string) []*Lang {
+	langs, _ := p.ParseManyContext(context.Background(), codes)
+	return langs
+}
+
+// ParseManyContext is like ParseMany, but stops parsing when the context is done,
+// and returns the error of the context.
+func (p *LangParser) ParseManyContext(ctx context.Context, codes []string) ([]*Lang, error) {
+	parsed := make(map[string]*Lang, len(codes))
+	langs := make([]*Lang, len(codes))
+	for i, code := range codes {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		lang, ok := parsed[code]
+		if !ok {
+			lang = p.Parse(code)
+			parsed[code] = lang
+		}
+		langs[i] = lang
 	}
-	return nil
+	return langs, nil
+}
+
+// ParseManyMap is like ParseMany, but returns the languages keyed by the language codes.
+//
+// If a language code is not found, it is still in the map, with a nil language.
+func (p *LangParser) ParseManyMap(codes []string) map[string]*Lang {
+	langs := make(map[string]*Lang, len(codes))
+	for _, code := range codes {
+		if _, ok := langs[code]; !ok {
+			langs[code] = p.Parse(code)
+		}
+	}
+	return langs
+}
+
+func (p *LangParser) pickBest(langs []Lang) *Lang {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	for i, lang := range langs {
+		switch {
+		case p.policy == HasRegion && lang.Region() != "":
+			return &langs[i]
+		case p.policy == HasValidWinID && lang.IsValidWinID():
+			return &langs[i]
+		}
+	}
+	return firstOrNil(langs)
+}
+
+// NormalizeCode returns the language code in the form the parser compares codes internally:
+// spaces around it trimmed, ASCII letters in lower case, and underscore (_) replaced by dash (-)
+// (example: " zh_Hant_TW " will return "zh-hant-tw").
+//
+// Two codes are matched as the same one by the parser if their normalized forms are equal, so it can be used
+// to canonicalize keys before storing them. Use CanonicalizeBCP47 for the canonical casing of RFC 5646 instead.
+func NormalizeCode(code string) string {
+	return stdBCP47Tag(strings.TrimSpace(code))
 }
 
 func stdBCP47Tag(tag string) string {
-	return strings.ToLower(strings.ReplaceAll(tag, "_", "-"))
+	return toLowerASCII(strings.ReplaceAll(tag, "_", "-"))
+}
+
+// equalFoldASCII reports whether a and b are equal under ASCII-only case folding.
+//
+// Unlike strings.EqualFold, non-ASCII characters are never folded into ASCII ones
+// (example: Kelvin sign "\u212A" is not equal to "k"), as all language codes are in ASCII.
+func equalFoldASCII(a, b string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if lowerASCII(a[i]) != lowerASCII(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// hasPrefixFoldASCII reports whether s begins with prefix under ASCII-only case folding, without allocating.
+func hasPrefixFoldASCII(s, prefix string) bool {
+	return len(s) >= len(prefix) && equalFoldASCII(s[:len(prefix)], prefix)
+}
+
+// toLowerASCII returns s with ASCII letters mapped to lower case, leaving non-ASCII characters unchanged.
+func toLowerASCII(s string) string {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 'A' && s[i] <= 'Z' {
+			b := []byte(s)
+			for j := i; j < len(b); j++ {
+				b[j] = lowerASCII(b[j])
+			}
+			return string(b)
+		}
+	}
+	return s
+}
+
+func lowerASCII(c byte) byte {
+	if c >= 'A' && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+	return c
+}
+
+func isASCIIAlpha(s string) bool {
+	for _, c := range s {
+		if c < 'A' || (c > 'Z' && c < 'a') || c > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+func isASCIIDigit(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func isASCIIAlphaNum(s string) bool {
+	for _, c := range s {
+		if (c < 'A' || (c > 'Z' && c < 'a') || c > 'z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
 }
 
 func sortByBCP47Tag(langs []Lang) {
-	sort.Slice(langs, func(i, j int) bool {
-		if len(langs[i].BCP47) == len(langs[j].BCP47) {
-			return langs[i].BCP47 < langs[j].BCP47
+	SortResults(langs, SortByBCP47)
+}
+
+// uniqueLangs removes duplicated languages from langs in place, keeping the first occurrence of each.
+func uniqueLangs(langs []Lang) []Lang {
+	if len(langs) < 2 {
+		return langs
+	}
+	seen := make(map[Lang]bool, len(langs))
+	unique := langs[:0]
+	for _, lang := range langs {
+		if !seen[lang] {
+			seen[lang] = true
+			unique = append(unique, lang)
 		}
-		return len(langs[i].BCP47) < len(langs[j].BCP47)
-	})
+	}
+	return unique
+}
+
+// formatLCID formats MSLCID as a 4-digit hex number (example: 0x0409).
+func formatLCID(lcid uint32) string {
+	return fmt.Sprintf("0x%04X", lcid)
 }
 
 func firstOrNil(langs []Lang) *Lang {
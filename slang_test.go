@@ -1,6 +1,15 @@
 package slang_test
 
 import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/baobao1270/slang"
@@ -110,6 +119,68 @@ func TestFindAllByBCP47InvalidSubLanguage(t *testing.T) {
 	}
 }
 
+func TestFindAllByBCP47DescendantCaseIgnorance(t *testing.T) {
+	lp, err := slang.NewParserFromReader(strings.NewReader(""))
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	lp.AddCustom(slang.Lang{Name: "Klingon", BCP47: "KG-su"})
+
+	if langs := lp.FindAllByBCP47("kG"); len(langs) != 1 || langs[0].BCP47 != "KG-su" {
+		t.Errorf("Error: FindAllByBCP47(kG) should find descendant 'KG-su', got %v", langs)
+	}
+	if langs := lp.FindAllByBCP47("kgs"); len(langs) != 0 {
+		t.Errorf("Error: FindAllByBCP47(kgs) should be empty, got %v", langs)
+	}
+}
+
+func TestFindAllByBCP47Wildcard(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	all := lp.FindAllByBCP47("*")
+	if len(all) == 0 || len(all) > lp.Len() {
+		t.Errorf("Error: FindAllByBCP47(*) should return all languages, got %d of %d", len(all), lp.Len())
+	}
+	for i := 1; i < len(all); i++ {
+		if len(all[i-1].BCP47) > len(all[i].BCP47) {
+			t.Errorf("Error: FindAllByBCP47(*) should be sorted by BCP47 tag length, got %s before %s", all[i-1].BCP47, all[i].BCP47)
+			break
+		}
+	}
+	if !reflect.DeepEqual(lp.FindAllByBCP47("en-*"), lp.FindAllByBCP47("en")) {
+		t.Errorf("Error: FindAllByBCP47(en-*) should be the same as FindAllByBCP47(en)")
+	}
+	if !reflect.DeepEqual(lp.FindAllByBCP47("en-*-US"), lp.FindAllByBCP47("en-US")) {
+		t.Errorf("Error: FindAllByBCP47(en-*-US) should be the same as FindAllByBCP47(en-US), got %v", lp.FindAllByBCP47("en-*-US"))
+	}
+	if langs := lp.FindAllByBCP47("*-US"); len(langs) != 0 {
+		t.Errorf("Error: FindAllByBCP47(*-US) should be empty, got %v", langs)
+	}
+}
+
+func TestFindByBCP47Wildcard(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	if lang := lp.FindByBCP47("*"); lang != nil {
+		t.Errorf("Error: FindByBCP47(*) should be nil, got %v", lang)
+	}
+	if lang := lp.Parse("*"); lang != nil {
+		t.Errorf("Error: Parse(*) should be nil, got %v", lang)
+	}
+	if _, err := lp.ParseE("*"); !errors.Is(err, slang.ErrNotFound) {
+		t.Errorf("Error: ParseE(*) should return ErrNotFound, got %v", err)
+	}
+	if lang := lp.FindByBCP47("en-*"); lang == nil || lang.BCP47 != "en" {
+		t.Errorf("Error: FindByBCP47(en-*) should be 'en', got %v", lang)
+	}
+}
+
 func TestFindAllByBCP47Chinese(t *testing.T) {
 	lp, err := slang.NewParser()
 	if err != nil {
@@ -347,3 +418,1070 @@ func TestFindByISO639Set3(t *testing.T) {
 		t.Errorf("Error: FindByISO639Set3(azj) should be 'azj'")
 	}
 }
+
+func TestEntries(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	entries := lp.Entries()
+	if len(entries) != lp.Len() {
+		t.Errorf("Error: len(Entries()) should be equal to Len()")
+	}
+
+	entries[0].Name = "Modified"
+	if lp.Entries()[0].Name == "Modified" {
+		t.Errorf("Error: Entries() should return a copy of internal data")
+	}
+
+	lp.AddCustom(slang.Lang{Name: "Klingon", BCP47: "kg-SU"})
+	if lp.Len() != len(entries)+1 {
+		t.Errorf("Error: Len() should grow after AddCustom")
+	}
+}
+
+func TestBestPolicy(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	lang := lp.FindByISO639Set1("gsw")
+	if lang.BCP47 != "gsw" {
+		t.Errorf("Error: FindByISO639Set1(gsw) with ShortestTag should be 'gsw'")
+	}
+
+	lang = lp.WithBestPolicy(slang.HasRegion).FindByISO639Set1("gsw")
+	if lang.BCP47 != "gsw-CH" {
+		t.Errorf("Error: FindByISO639Set1(gsw) with HasRegion should be 'gsw-CH'")
+	}
+
+	lang = lp.WithBestPolicy(slang.HasValidWinID).FindByISO639Set1("gsw")
+	if lang.BCP47 != "gsw-FR" {
+		t.Errorf("Error: FindByISO639Set1(gsw) with HasValidWinID should be 'gsw-FR'")
+	}
+
+	lang = lp.WithBestPolicy(slang.HasValidWinID).FindByISO639Set1("aa")
+	if lang.BCP47 != "aa" {
+		t.Errorf("Error: FindByISO639Set1(aa) with HasValidWinID should fall back to 'aa'")
+	}
+
+	lang = lp.WithBestPolicy(slang.HasRegion).FindByBCP47("bho-Deva")
+	if lang.BCP47 != "bho-Deva-IN" {
+		t.Errorf("Error: FindByBCP47(bho-Deva) with HasRegion should be 'bho-Deva-IN'")
+	}
+}
+
+func TestLangString(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	if s := fmt.Sprintf("%v", *lp.FindByBCP47("fr-FR")); s != "French (France) [fr-FR]" {
+		t.Errorf("Error: String() of fr-FR should be 'French (France) [fr-FR]', got %q", s)
+	}
+	if s := lp.FindByBCP47("fr").String(); s != "French [fr]" {
+		t.Errorf("Error: String() of fr should be 'French [fr]', got %q", s)
+	}
+}
+
+func TestLangTabString(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	s := lp.FindByBCP47("en-US").TabString()
+	if s != "English\tUnited States\t0x0409\ten-US\tENU\ten\teng\teng" {
+		t.Errorf("Error: TabString() of en-US is %q", s)
+	}
+}
+
+func TestLangEqual(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	enUS, enGB, fr := *lp.FindByBCP47("en-US"), *lp.FindByBCP47("en-GB"), *lp.FindByBCP47("fr")
+	if !enUS.Equal(*lp.FindByBCP47("en_us")) {
+		t.Errorf("Error: Equal() of en-US and en_us should be true")
+	}
+	if enUS.Equal(enGB) {
+		t.Errorf("Error: Equal() of en-US and en-GB should be false")
+	}
+	other := enUS
+	other.MSLCID = 0x0809
+	if enUS.Equal(other) {
+		t.Errorf("Error: Equal() should compare MSLCID")
+	}
+	if !enUS.SameLanguage(enGB) {
+		t.Errorf("Error: SameLanguage() of en-US and en-GB should be true")
+	}
+	if enUS.SameLanguage(fr) {
+		t.Errorf("Error: SameLanguage() of en-US and fr should be false")
+	}
+	if !(slang.Lang{ISO639Set3: "eng"}).SameLanguage(slang.Lang{BCP47: "en", ISO639Set3: "ENG"}) {
+		t.Errorf("Error: SameLanguage() should compare ISO 639-3 when BCP47 is empty")
+	}
+	if (slang.Lang{}).SameLanguage(slang.Lang{}) {
+		t.Errorf("Error: SameLanguage() of empty languages should be false")
+	}
+}
+
+func TestLangKey(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	if key := lp.FindByBCP47("en_us").Key(); key != "en-US/eng" {
+		t.Errorf("Error: Key() of en-US should be 'en-US/eng', got %q", key)
+	}
+	if key := (slang.Lang{BCP47: "ZH", ISO639Set3: "CMN"}).Key(); key != "zh/cmn" {
+		t.Errorf("Error: Key() of zh (cmn) should be 'zh/cmn', got %q", key)
+	}
+
+	keys := map[string]bool{}
+	for _, lang := range lp.Entries() {
+		if keys[lang.Key()] {
+			t.Errorf("Error: Key() %q of embedded database is not unique", lang.Key())
+		}
+		keys[lang.Key()] = true
+	}
+}
+
+func TestLoadCSV(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	count := lp.Len()
+
+	err = lp.LoadCSV(strings.NewReader("id,name,location,lcid,bcp47,winid,iso639_1,iso639_2,iso639_3\n" +
+		"1,Klingon,Star Trek Universe,0x0000,kg-SU,KLI,kg,tlh,tlh\n" +
+		"2,Dothraki,Essos,0x1000,dt-ES,ZZZ,dt,dth,dth\n"))
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	if lp.Len() != count+2 {
+		t.Errorf("Error: LoadCSV should add 2 languages")
+	}
+	if lp.Parse("kg-SU").Name != "Klingon" {
+		t.Errorf("Error: Loaded language 'Klingon' not found (parse: kg-SU)")
+	}
+	if lp.Parse("dth").Name != "Dothraki" {
+		t.Errorf("Error: Loaded language 'Dothraki' not found (parse: dth)")
+	}
+	if lp.Parse("en-US").Name != "English" {
+		t.Errorf("Error: Embedded language 'English' not found after LoadCSV (parse: en-US)")
+	}
+}
+
+func TestLoadCSVMalformed(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	count := lp.Len()
+
+	for _, data := range []string{
+		"1,Klingon,Star Trek Universe,0x0000,kg-SU,KLI,kg,tlh,tlh\n2,Dothraki,Essos,0x1000,dt-ES\n",
+		"1,Klingon,Star Trek Universe,0xZZZZ,kg-SU,KLI,kg,tlh,tlh\n",
+		"1,Klingon,Star Trek Universe,,kg-SU,KLI,kg,tlh,tlh\n",
+	} {
+		if err := lp.LoadCSV(strings.NewReader(data)); !errors.Is(err, slang.ErrParse) {
+			t.Errorf("Error: LoadCSV(%q) should return ErrParse, got %v", data, err)
+		}
+	}
+	if lp.Len() != count {
+		t.Errorf("Error: LoadCSV with malformed input should not change the parser")
+	}
+}
+
+func TestConcurrentParseAndAddCustom(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				if lp.Parse("en-US") == nil {
+					t.Errorf("Error: Parse(en-US) should not be nil")
+				}
+				lp.FindByWinID("CHS")
+				lp.Entries()
+			}
+		}()
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				lp.AddCustom(slang.Lang{Name: "Klingon", BCP47: fmt.Sprintf("kg-%d-%d", i, j)})
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if len(lp.FindAllByBCP47("kg")) != 8*50 {
+		t.Errorf("Error: FindAllByBCP47(kg) should find all %d custom languages", 8*50)
+	}
+}
+
+func TestClone(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	clone := lp.WithIndex().WithBestPolicy(slang.HasRegion).Clone()
+	clone.AddCustom(slang.Lang{Name: "Klingon", BCP47: "kg-SU", WinID: "KLI"})
+
+	if clone.Parse("kg-SU") == nil || clone.Parse("KLI") == nil {
+		t.Errorf("Error: Custom language 'Klingon' not found in clone")
+	}
+	if lp.Parse("kg-SU") != nil || lp.Parse("KLI") != nil {
+		t.Errorf("Error: Custom language 'Klingon' should not leak into the original parser")
+	}
+	if clone.Len() != lp.Len()+1 {
+		t.Errorf("Error: Clone should have one more language than the original parser")
+	}
+	if lang := clone.FindByISO639Set1("gsw"); lang.BCP47 != "gsw-CH" {
+		t.Errorf("Error: Clone should keep the best policy of the original parser")
+	}
+}
+
+func TestRemoveByBCP47(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	count := lp.Len()
+
+	lp.WithIndex().AddCustom(slang.Lang{Name: "Klingon", BCP47: "kg-SU"}).AddCustom(slang.Lang{Name: "Klingon", BCP47: "KG_su"})
+	if removed := lp.RemoveByBCP47("kg-su"); removed != 2 {
+		t.Errorf("Error: RemoveByBCP47(kg-su) should remove 2 languages, got %d", removed)
+	}
+	if lp.Parse("kg-SU") != nil {
+		t.Errorf("Error: Parse(kg-SU) should be nil after removal")
+	}
+	if lp.Len() != count {
+		t.Errorf("Error: Len() should be %d after removal, got %d", count, lp.Len())
+	}
+	if removed := lp.RemoveByBCP47("kg-SU"); removed != 0 {
+		t.Errorf("Error: RemoveByBCP47(kg-SU) should remove nothing, got %d", removed)
+	}
+
+	before := lp.Entries()
+	lp.RemoveByBCP47("en-US")
+	after := lp.Entries()
+	for i, j := 0, 0; i < len(before); i++ {
+		if before[i].BCP47 == "en-US" {
+			continue
+		}
+		if before[i] != after[j] {
+			t.Errorf("Error: RemoveByBCP47 should preserve the order of remaining languages")
+			break
+		}
+		j++
+	}
+	if lp.Parse("en-US").BCP47 != "en" {
+		t.Errorf("Error: Parse(en-US) should fall back to 'en' after removal")
+	}
+}
+
+func TestUpsert(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	count := lp.Len()
+
+	lp.WithIndex().Upsert(slang.Lang{Name: "Klingon", BCP47: "kg-SU", WinID: "KLG"})
+	lp.Upsert(slang.Lang{Name: "Klingon (corrected)", BCP47: "KG-su", WinID: "KLI"})
+
+	if lp.Len() != count+1 {
+		t.Errorf("Error: Upsert should add only 1 language, got %d", lp.Len()-count)
+	}
+	if lang := lp.Parse("kg-SU"); lang.Name != "Klingon (corrected)" {
+		t.Errorf("Error: Upsert should replace the existing language, got %v", lang)
+	}
+	if lang := lp.FindByWinID("KLG"); lang != nil {
+		t.Errorf("Error: FindByWinID(KLG) should be nil after replacement")
+	}
+
+	lp.Upsert(slang.Lang{Name: "English (Custom)", BCP47: "en-US", WinID: "ENU"})
+	if lang := lp.Parse("en-US"); lang.Name != "English (Custom)" {
+		t.Errorf("Error: Upsert should replace embedded language 'en-US', got %v", lang)
+	}
+}
+
+func TestFindAllDeduplicated(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	klingon := slang.Lang{Name: "Klingon", BCP47: "kg-SU", ISO639Set1: "kg"}
+	lp.AddCustom(klingon).AddCustom(klingon).AddCustom(slang.Lang{Name: "Klingon", BCP47: "kg"})
+
+	if langs := lp.FindAllByBCP47("kg-SU"); len(langs) != 2 {
+		t.Errorf("Error: FindAllByBCP47(kg-SU) should have 2 languages, got %v", langs)
+	}
+	if langs := lp.FindAllByISO639Set1("kg"); len(langs) != 1 {
+		t.Errorf("Error: FindAllByISO639Set1(kg) should have 1 language, got %v", langs)
+	}
+}
+
+func TestDeduplicate(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	if removed := lp.Deduplicate(); removed != 0 {
+		t.Errorf("Error: Deduplicate() of embedded database should remove nothing, got %d", removed)
+	}
+	count := lp.Len()
+
+	err = lp.LoadCSV(strings.NewReader("1,Klingon,Star Trek Universe,0x0000,kg-SU,KLI,kg,tlh,tlh\n" +
+		"2,English,United States,0x0409,en-US,ENU,en,eng,eng\n" +
+		"3,Klingon,Star Trek Universe,0x0000,kg-SU,KLI,kg,tlh,tlh\n" +
+		"4,Klingon,Qo'noS,0x0000,kg-SU,KLI,kg,tlh,tlh\n"))
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	if removed := lp.WithIndex().Deduplicate(); removed != 2 {
+		t.Errorf("Error: Deduplicate() should remove 2 languages, got %d", removed)
+	}
+	entries := lp.Entries()
+	if len(entries) != count+2 {
+		t.Errorf("Error: Len() should be %d after Deduplicate(), got %d", count+2, len(entries))
+	}
+	if entries[count].Location != "Star Trek Universe" || entries[count+1].Location != "Qo'noS" {
+		t.Errorf("Error: Deduplicate() should keep the first occurrence and the order")
+	}
+}
+
+func TestWinIDASCIIFold(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	for _, id := range []string{"ıns", "İNS", "\u212Aor", "zzZ"} {
+		if slang.IsValidWinID(id) {
+			t.Errorf("Error: IsValidWinID(%s) should be false", id)
+		}
+		if lang := lp.FindByWinID(id); lang != nil {
+			t.Errorf("Error: FindByWinID(%s) should be nil, got %v", id, lang)
+		}
+	}
+
+	if lang := lp.FindByWinID("eNu"); lang == nil || lang.WinID != "ENU" {
+		t.Errorf("Error: FindByWinID(eNu) should be 'ENU'")
+	}
+}
+
+func TestFindAllByWinIDPrefix(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	langs := lp.FindAllByWinIDPrefix("en")
+	if !hasBCP47(langs, "en") || !hasBCP47(langs, "en-AU") || !hasBCP47(langs, "en-BZ") {
+		t.Errorf("Error: FindAllByWinIDPrefix(en) should have en, en-AU and en-BZ, got %v", langs)
+	}
+	for _, lang := range langs {
+		if !strings.HasPrefix(lang.WinID, "EN") {
+			t.Errorf("Error: FindAllByWinIDPrefix(en) should not have '%s' (%s)", lang.BCP47, lang.WinID)
+		}
+	}
+	if langs := lp.FindAllByWinIDPrefix("eNu"); !reflect.DeepEqual(langs, lp.FindAllByWinID("ENU")) {
+		t.Errorf("Error: FindAllByWinIDPrefix(eNu) should be the same as FindAllByWinID(ENU), got %v", langs)
+	}
+	for _, prefix := range []string{"", "ENUS", "E1", "zz"} {
+		if langs := lp.FindAllByWinIDPrefix(prefix); len(langs) != 0 {
+			t.Errorf("Error: FindAllByWinIDPrefix(%s) should be empty, got %v", prefix, langs)
+		}
+	}
+}
+
+func TestIsValidWinIDAllocs(t *testing.T) {
+	for _, id := range []string{"ENU", "zzZ", "EN", "ZZ1"} {
+		if allocs := testing.AllocsPerRun(100, func() { slang.IsValidWinID(id) }); allocs != 0 {
+			t.Errorf("Error: IsValidWinID(%s) should not allocate, got %v allocations", id, allocs)
+		}
+	}
+}
+
+func TestValidateWinID(t *testing.T) {
+	tests := map[string]error{
+		"CHS":  nil,
+		"enu":  nil,
+		"EN":   slang.ErrWinIDLength,
+		"ENUS": slang.ErrWinIDLength,
+		"":     slang.ErrWinIDLength,
+		"ZZ1":  slang.ErrWinIDNonAlpha,
+		"ıns":  slang.ErrWinIDLength,
+		"E-N":  slang.ErrWinIDNonAlpha,
+		"ZZZ":  slang.ErrWinIDSentinel,
+		"zzZ":  slang.ErrWinIDSentinel,
+	}
+	for id, expected := range tests {
+		err := slang.ValidateWinID(id)
+		if expected == nil {
+			if err != nil {
+				t.Errorf("Error: ValidateWinID(%s) should be nil, got %v", id, err)
+			}
+			continue
+		}
+		if !errors.Is(err, expected) || !errors.Is(err, slang.ErrInvalidWinID) {
+			t.Errorf("Error: ValidateWinID(%s) should be %v, got %v", id, expected, err)
+		}
+		if slang.IsValidWinID(id) {
+			t.Errorf("Error: IsValidWinID(%s) should be false", id)
+		}
+	}
+	if err := slang.ValidateWinID("ZZ1"); err.Error() != `invalid Windows language ID: has a non-letter character: "ZZ1"` {
+		t.Errorf("Error: ValidateWinID(ZZ1) should name the reason, got %v", err)
+	}
+}
+
+func TestCodeASCIIFold(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	for _, lp := range []*slang.LangParser{lp, lp.Clone().WithIndex()} {
+		if lang := lp.FindByISO639Set3("\u212Aor"); lang != nil {
+			t.Errorf("Error: FindByISO639Set3(\\u212Aor) should be nil, got %v", lang)
+		}
+		if lang := lp.FindByBCP47("\u212Ao-KR"); lang != nil {
+			t.Errorf("Error: FindByBCP47(\\u212Ao-KR) should be nil, got %v", lang)
+		}
+		if lang := lp.FindByISO639Set3("KOR"); lang == nil {
+			t.Errorf("Error: FindByISO639Set3(KOR) should not be nil")
+		}
+	}
+}
+
+func TestFindAllByLocation(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	for _, lp := range []*slang.LangParser{lp, lp.Clone().WithIndex()} {
+		langs := lp.FindAllByLocation("fRANCE")
+		if len(langs) == 0 {
+			t.Errorf("Error: FindAllByLocation(France) should not be empty")
+		}
+		for _, lang := range langs {
+			if lang.Location != "France" {
+				t.Errorf("Error: FindAllByLocation(France) should not contain %v", lang)
+			}
+		}
+
+		langs = lp.FindAllByLocation("Bonaire, Sint Eustatius and Saba")
+		if len(langs) == 0 || langs[0].BCP47 != "nl-BQ" {
+			t.Errorf("Error: FindAllByLocation(Bonaire, Sint Eustatius and Saba) should be 'nl-BQ'")
+		}
+
+		if langs := lp.FindAllByLocation("Fran"); len(langs) != 0 {
+			t.Errorf("Error: FindAllByLocation(Fran) should be empty")
+		}
+	}
+}
+
+func TestSearchByLocation(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	langs := lp.SearchByLocation("congo")
+	if len(langs) == 0 {
+		t.Errorf("Error: SearchByLocation(congo) should not be empty")
+	}
+	for i, lang := range langs {
+		if !strings.Contains(strings.ToLower(lang.Location), "congo") {
+			t.Errorf("Error: SearchByLocation(congo) should not contain %v", lang)
+		}
+		if i > 0 && len(lang.BCP47) < len(langs[i-1].BCP47) {
+			t.Errorf("Error: SearchByLocation(congo) should be sorted by BCP47 tag length")
+		}
+	}
+
+	if langs := lp.SearchByLocation(""); len(langs) != 0 {
+		t.Errorf("Error: SearchByLocation() should be empty")
+	}
+}
+
+func TestAll(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	entries := lp.Entries()
+	i := 0
+	for lang := range lp.All() {
+		if lang != entries[i] {
+			t.Errorf("Error: All() should iterate in database order")
+		}
+		i++
+	}
+	if i != len(entries) {
+		t.Errorf("Error: All() should iterate over %d languages, got %d", len(entries), i)
+	}
+
+	for range lp.All() {
+		lp.AddCustom(slang.Lang{Name: "Klingon", BCP47: "kg-SU"})
+		break
+	}
+	if lp.Len() != len(entries)+1 {
+		t.Errorf("Error: AddCustom during iteration should not deadlock")
+	}
+}
+
+func TestFilter(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	count := 0
+	for lang := range lp.Filter(func(lang slang.Lang) bool { return lang.ISO639Set1 == "zh" }) {
+		if lang.ISO639Set1 != "zh" {
+			t.Errorf("Error: Filter() should only yield matching languages, got %v", lang)
+		}
+		count++
+		if count == 3 {
+			break
+		}
+	}
+	if count != 3 {
+		t.Errorf("Error: Filter() should yield at least 3 Chinese languages")
+	}
+}
+
+func TestWhereAndSelect(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	langs := lp.Where(func(lang slang.Lang) bool { return lang.ISO639Set1 == lang.ISO639Set2 })
+	if len(langs) == 0 {
+		t.Errorf("Error: Where(ISO639Set1 == ISO639Set2) should not be empty")
+	}
+	for _, lang := range langs {
+		if lang.ISO639Set1 != lang.ISO639Set2 {
+			t.Errorf("Error: Where(ISO639Set1 == ISO639Set2) should not contain %v", lang)
+		}
+	}
+
+	tags := slang.Select(lp.Where(func(lang slang.Lang) bool { return lang.ISO639Set3 == "bho" }), func(lang slang.Lang) string {
+		return lang.BCP47
+	})
+	if !reflect.DeepEqual(tags, []string{"bho", "bho-Deva", "bho-Deva-IN"}) {
+		t.Errorf("Error: Select(Where(bho)) should be [bho bho-Deva bho-Deva-IN] in database order, got %v", tags)
+	}
+
+	if langs := lp.Where(func(lang slang.Lang) bool { return false }); langs == nil || len(langs) != 0 {
+		t.Errorf("Error: Where(false) should be an empty slice")
+	}
+}
+
+func TestGroupByLanguage(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	groups := lp.GroupByLanguage()
+	tags := slang.Select(groups["bho"], func(lang slang.Lang) string { return lang.BCP47 })
+	if !reflect.DeepEqual(tags, []string{"bho", "bho-Deva", "bho-Deva-IN"}) {
+		t.Errorf("Error: GroupByLanguage()[bho] should be [bho bho-Deva bho-Deva-IN], got %v", tags)
+	}
+	if zh := groups["zh"]; len(zh) == 0 || zh[0].BCP47 != "zh" || !hasBCP47(zh, "zh-TW") || hasBCP47(zh, "bho") {
+		t.Errorf("Error: GroupByLanguage()[zh] should start with 'zh' and contain 'zh-TW'")
+	}
+
+	total := 0
+	for _, group := range groups {
+		total += len(group)
+	}
+	if total != lp.Len() {
+		t.Errorf("Error: GroupByLanguage() should contain %d languages, got %d", lp.Len(), total)
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	lp.AddCustom(slang.Lang{
+		Name:       "Klingon",
+		Location:   "Star Trek Universe, Qo'noS",
+		MSLCID:     0x0000,
+		BCP47:      "kg-SU",
+		WinID:      "KLI",
+		ISO639Set1: "kg",
+		ISO639Set2: "tlh",
+		ISO639Set3: "tlh",
+	})
+
+	var buf bytes.Buffer
+	if err := lp.WriteCSV(&buf); err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	if !strings.HasPrefix(buf.String(), "id,name,location,lcid,bcp47,winid,iso639_1,iso639_2,iso639_3\n") {
+		t.Errorf("Error: WriteCSV() should start with header row")
+	}
+	if !strings.Contains(buf.String(), ",English,United States,0x0409,en-US,ENU,en,eng,eng\n") {
+		t.Errorf("Error: WriteCSV() should contain en-US with hex MSLCID")
+	}
+
+	read, err := slang.NewParserFromReader(&buf)
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	if !reflect.DeepEqual(read.Entries(), lp.Entries()) {
+		t.Errorf("Error: Parser read from WriteCSV() should be equal to the original parser")
+	}
+}
+
+func TestNewParserFromReader(t *testing.T) {
+	lp, err := slang.NewParserFromReader(strings.NewReader("1,Klingon,Star Trek Universe,0x0000,kg-SU,KLI,kg,tlh,tlh\n"))
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	if lp.Len() != 1 || lp.Parse("kg-SU") == nil || lp.Parse("en-US") != nil {
+		t.Errorf("Error: NewParserFromReader should only contain 'Klingon'")
+	}
+
+	if _, err := slang.NewParserFromReader(strings.NewReader("1,Klingon\n")); !errors.Is(err, slang.ErrParse) {
+		t.Errorf("Error: NewParserFromReader with malformed input should return ErrParse, got %v", err)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	tests := map[string]struct {
+		line  int
+		field string
+		text  string
+	}{
+		"id,name,location,lcid,bcp47,winid,iso639_1,iso639_2,iso639_3\n1,Klingon,,0x0000,tlh,ZZZ,tlh,tlh,tlh\n2,Klingon,,0xZZZZ,tlh,ZZZ,tlh,tlh,tlh\n": {3, "lcid", `line 3: invalid lcid "0xZZZZ"`},
+		"1,Klingon,,0x0000,tlh,ZZZ,tlh,tlh,tlh\n2,Klingon,,1033,tlh,ZZZ,tlh,tlh,tlh\n":                                                                 {2, "lcid", `line 2: invalid lcid "1033"`},
+		"1,Klingon,,0x0000,tlh,ZZZ,tlh,tlh,tlh\n2,Klingon\n":                                                                                           {2, "", "line 2: expected 9 fields, got 2"},
+		"1,Klingon,,0x0000,tlh,ZZZ,tlh,tlh,tlh\n\"2,Klingon\n":                                                                                         {2, "", "line 2"},
+	}
+	for csv, expected := range tests {
+		_, err := slang.NewParserFromReader(strings.NewReader(csv))
+		var parseErr *slang.ParseError
+		if !errors.Is(err, slang.ErrParse) || !errors.As(err, &parseErr) {
+			t.Errorf("Error: NewParserFromReader(%q) should return a ParseError wrapping ErrParse, got %v", csv, err)
+			continue
+		}
+		if parseErr.Line != expected.line || parseErr.Field != expected.field || !strings.Contains(err.Error(), expected.text) {
+			t.Errorf("Error: NewParserFromReader(%q) should fail at line %d field '%s' with '%s', got %v", csv, expected.line, expected.field, expected.text, err)
+		}
+		if expected.field == "lcid" && !errors.Is(err, slang.ErrInvalidLCID) {
+			t.Errorf("Error: NewParserFromReader(%q) should wrap ErrInvalidLCID, got %v", csv, err)
+		}
+	}
+}
+
+func TestNewParserFromReaderHeader(t *testing.T) {
+	csv := "BCP47,name,extra,iso639_3,iso639_2,iso639_1,winid,lcid,location\n" +
+		"kg-SU,Klingon,ignored,tlh,tlh,kg,KLI,0x0000,Star Trek Universe\n"
+	lp, err := slang.NewParserFromReader(strings.NewReader(csv))
+	if err != nil {
+		t.Errorf("Error: %v", err)
+		return
+	}
+
+	expected := slang.Lang{Name: "Klingon", Location: "Star Trek Universe", BCP47: "kg-SU", WinID: "KLI", ISO639Set1: "kg", ISO639Set2: "tlh", ISO639Set3: "tlh"}
+	if entries := lp.Entries(); len(entries) != 1 || entries[0] != expected {
+		t.Errorf("Error: NewParserFromReader with reordered header should only contain %v, got %v", expected, entries)
+	}
+
+	_, err = slang.NewParserFromReader(strings.NewReader("id,name,bcp47\n1,Klingon,kg-SU\n"))
+	if !errors.Is(err, slang.ErrParse) || !strings.Contains(err.Error(), `missing column "location"`) {
+		t.Errorf("Error: NewParserFromReader with missing column should return ErrParse, got %v", err)
+	}
+	_, err = slang.NewParserFromReader(strings.NewReader(csv + "kg-SU,Klingon\n"))
+	if !errors.Is(err, slang.ErrParse) {
+		t.Errorf("Error: NewParserFromReader with short row should return ErrParse, got %v", err)
+	}
+}
+
+func TestNewParserFromReaderBOM(t *testing.T) {
+	csv := "\xEF\xBB\xBFid,name,location,lcid,bcp47,winid,iso639_1,iso639_2,iso639_3\n" +
+		"1, Klingon , Star Trek Universe ,  0x0000 , kg-SU ,KLI ,kg,tlh, tlh\n"
+	lp, err := slang.NewParserFromReader(strings.NewReader(csv))
+	if err != nil {
+		t.Errorf("Error: %v", err)
+		return
+	}
+
+	expected := slang.Lang{Name: "Klingon", Location: "Star Trek Universe", BCP47: "kg-SU", WinID: "KLI", ISO639Set1: "kg", ISO639Set2: "tlh", ISO639Set3: "tlh"}
+	if entries := lp.Entries(); len(entries) != 1 || entries[0] != expected {
+		t.Errorf("Error: NewParserFromReader with BOM and spaces should only contain %v, got %v", expected, entries)
+	}
+	if err := lp.LoadCSV(strings.NewReader("\xEF\xBB\xBF2,Hol,,0x0000,tlh,ZZZ,tlh,tlh,tlh\n")); err != nil || lp.Parse("tlh") == nil {
+		t.Errorf("Error: LoadCSV with BOM should succeed, got %v", err)
+	}
+}
+
+func TestParseE(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	lang, err := lp.ParseE("en-US")
+	if err != nil || lang.BCP47 != "en-US" {
+		t.Errorf("Error: ParseE(en-US) should be 'en-US', got %v, %v", lang, err)
+	}
+
+	for _, value := range []string{"", "  "} {
+		if lang, err := lp.ParseE(value); lang != nil || !errors.Is(err, slang.ErrEmptyInput) {
+			t.Errorf("Error: ParseE(%q) should return ErrEmptyInput, got %v", value, err)
+		}
+	}
+
+	lang, err = lp.ParseE("invalid")
+	if lang != nil || !errors.Is(err, slang.ErrNotFound) {
+		t.Errorf("Error: ParseE(invalid) should return ErrNotFound, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "invalid") {
+		t.Errorf("Error: ParseE(invalid) error should contain the value, got %v", err)
+	}
+}
+
+func TestParseMany(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	langs := lp.ParseMany([]string{"en-US", "invalid", "CHS", "en-US"})
+	if len(langs) != 4 || langs[0] == nil || langs[0].BCP47 != "en-US" || langs[1] != nil || langs[2] == nil || langs[2].BCP47 != "zh" {
+		t.Errorf("Error: ParseMany([en-US invalid CHS en-US]) should be [en-US nil zh en-US], got %v", langs)
+	}
+	if langs[0] != langs[3] {
+		t.Errorf("Error: ParseMany() should parse duplicated codes only once")
+	}
+	if langs := lp.ParseMany(nil); langs == nil || len(langs) != 0 {
+		t.Errorf("Error: ParseMany(nil) should be an empty slice")
+	}
+
+	parsed := lp.ParseManyMap([]string{"en-US", "invalid", "CHS", "en-US"})
+	if len(parsed) != 3 || parsed["en-US"].BCP47 != "en-US" || parsed["CHS"].BCP47 != "zh" {
+		t.Errorf("Error: ParseManyMap([en-US invalid CHS en-US]) should have 3 codes, got %v", parsed)
+	}
+	if lang, ok := parsed["invalid"]; !ok || lang != nil {
+		t.Errorf("Error: ParseManyMap() should keep codes not found with nil")
+	}
+}
+
+func TestStats(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	stats := lp.Stats()
+	if stats.Total != lp.Len() || lp.IsEmpty() {
+		t.Errorf("Error: Stats().Total should be equal to Len(), got %d", stats.Total)
+	}
+	if stats.Languages == 0 || stats.Languages >= stats.Total || stats.Regions == 0 || stats.ValidWinIDs == 0 || stats.ValidWinIDs > stats.Total {
+		t.Errorf("Error: Stats() should have consistent counts, got %+v", stats)
+	}
+
+	custom, err := slang.NewParserFromReader(strings.NewReader(""))
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	if !custom.IsEmpty() || custom.Stats() != (slang.Stats{}) {
+		t.Errorf("Error: Stats() of an empty parser should be zero")
+	}
+	custom.AddCustom(slang.Lang{BCP47: "en-US", WinID: "ENU"}).
+		AddCustom(slang.Lang{BCP47: "en-GB", WinID: "ZZZ"}).
+		AddCustom(slang.Lang{BCP47: "fr-FR", WinID: "FRA"}).
+		AddCustom(slang.Lang{BCP47: "fr"})
+	if stats := custom.Stats(); stats != (slang.Stats{Total: 4, Languages: 2, Regions: 3, ValidWinIDs: 2}) {
+		t.Errorf("Error: Stats() should be {4 2 3 2}, got %+v", stats)
+	}
+}
+
+func TestContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	csv := "1,Klingon,Star Trek Universe,0x0000,kg-SU,KLI,kg,tlh,tlh\n"
+
+	lp, err := slang.NewParserFromReaderContext(ctx, strings.NewReader(csv))
+	if err != nil || lp.Len() != 1 {
+		t.Errorf("Error: NewParserFromReaderContext should succeed, got %v", err)
+	}
+	if langs, err := lp.ParseManyContext(ctx, []string{"kg-SU"}); err != nil || len(langs) != 1 || langs[0] == nil {
+		t.Errorf("Error: ParseManyContext should succeed, got %v", err)
+	}
+
+	cancel()
+	if _, err := slang.NewParserFromReaderContext(ctx, strings.NewReader(csv)); !errors.Is(err, context.Canceled) {
+		t.Errorf("Error: NewParserFromReaderContext should return context.Canceled, got %v", err)
+	}
+	if err := lp.LoadCSVContext(ctx, strings.NewReader(csv)); !errors.Is(err, context.Canceled) || lp.Len() != 1 {
+		t.Errorf("Error: LoadCSVContext should return context.Canceled and leave the parser unchanged, got %v", err)
+	}
+	if _, err := lp.ParseManyContext(ctx, []string{"kg-SU"}); !errors.Is(err, context.Canceled) {
+		t.Errorf("Error: ParseManyContext should return context.Canceled, got %v", err)
+	}
+}
+
+func TestFindByMSLCID(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	if lang := lp.FindByMSLCID(0x0409); lang == nil || lang.BCP47 != "en-US" {
+		t.Errorf("Error: FindByMSLCID(0x0409) should be 'en-US', got %v", lang)
+	}
+	if langs := lp.FindAllByMSLCID(0x7804); len(langs) < 2 || langs[0].BCP47 != "zh" {
+		t.Errorf("Error: FindAllByMSLCID(0x7804) should find multiple 'zh' entries, got %v", langs)
+	}
+	if tags := lp.TagsForLCID(0x7804); !reflect.DeepEqual(tags, []string{"zh"}) {
+		t.Errorf("Error: TagsForLCID(0x7804) should be [zh], got %v", tags)
+	}
+	if tags := lp.TagsForLCID(0xFFFF); len(tags) != 0 {
+		t.Errorf("Error: TagsForLCID(0xFFFF) should be empty, got %v", tags)
+	}
+}
+
+func TestReservedLCID(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	lp.AddCustom(slang.Lang{Name: "Klingon", MSLCID: slang.NeutralLCID, BCP47: "kg-SU"})
+
+	for _, lcid := range []uint32{slang.NeutralLCID, slang.InvariantLCID, slang.CustomUnspecifiedLCID} {
+		if !slang.IsReservedLCID(lcid) {
+			t.Errorf("Error: IsReservedLCID(%#04x) should be true", lcid)
+		}
+		if lang := lp.FindByMSLCID(lcid); lang != nil {
+			t.Errorf("Error: FindByMSLCID(%#04x) should be nil, got %v", lcid, lang)
+		}
+	}
+	if slang.IsReservedLCID(0x0409) {
+		t.Errorf("Error: IsReservedLCID(0x0409) should be false")
+	}
+	if langs := lp.FindAllByMSLCID(slang.NeutralLCID); len(langs) != 1 || langs[0].BCP47 != "kg-SU" {
+		t.Errorf("Error: FindAllByMSLCID(0x0000) should find 'kg-SU', got %v", langs)
+	}
+	if lang := lp.WithParseOrder(slang.MatchLCID).Parse("0x007F"); lang != nil {
+		t.Errorf("Error: Parse(0x007F) with MatchLCID should be nil, got %v", lang)
+	}
+}
+
+func TestNormalizeCode(t *testing.T) {
+	for code, expected := range map[string]string{
+		"en-US":         "en-us",
+		" zh_Hant_TW\t": "zh-hant-tw",
+		"ENG":           "eng",
+		"x-Private_Use": "x-private-use",
+		"":              "",
+		"  ":            "",
+		"\u212Aor":      "\u212Aor",
+	} {
+		if normalized := slang.NormalizeCode(code); normalized != expected {
+			t.Errorf("Error: NormalizeCode(%q) should be %q, got %q", code, expected, normalized)
+		}
+	}
+
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	if lp.Parse(slang.NormalizeCode("EN_us")).BCP47 != "en-US" {
+		t.Errorf("Error: Parse of normalized code should be 'en-US'")
+	}
+}
+
+func TestFindAllByBCP47Scored(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	for tag, expected := range map[string]map[string]int{
+		"bho-Deva-IN":      {"bho-Deva-IN": 0, "bho-Deva": 1, "bho": 2},
+		"bho_deva":         {"bho-Deva": 0, "bho": 1, "bho-Deva-IN": -1},
+		"en-US-x-custom":   {"en-US": 0, "en": 1},
+		"bho-Deva-IN-u-nu": {"bho-Deva-IN": 0, "bho-Deva": 1, "bho": 2},
+	} {
+		matches := lp.FindAllByBCP47Scored(tag)
+		langs := lp.FindAllByBCP47(tag)
+		if len(matches) != len(langs) {
+			t.Errorf("Error: FindAllByBCP47Scored(%s) should have %d matches, got %d", tag, len(langs), len(matches))
+			continue
+		}
+		for i, match := range matches {
+			if match.Lang != langs[i] {
+				t.Errorf("Error: FindAllByBCP47Scored(%s)[%d] should be in the order of FindAllByBCP47", tag, i)
+			}
+			if distance, ok := expected[match.BCP47]; ok && match.Distance != distance {
+				t.Errorf("Error: Distance of '%s' in FindAllByBCP47Scored(%s) should be %d, got %d", match.BCP47, tag, distance, match.Distance)
+			}
+		}
+	}
+
+	lp.WithLikelySubtags()
+	for _, match := range lp.FindAllByBCP47Scored("zh-CN") {
+		if match.BCP47 == "zh-Hans" && match.Distance != -1 {
+			t.Errorf("Error: Distance of 'zh-Hans' in FindAllByBCP47Scored(zh-CN) should be -1, got %d", match.Distance)
+		}
+	}
+	if matches := lp.FindAllByBCP47Scored(""); len(matches) != 0 {
+		t.Errorf("Error: FindAllByBCP47Scored() should be empty, got %v", matches)
+	}
+}
+
+func TestLanguagesWithoutWinID(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	langs := lp.LanguagesWithoutWinID()
+	if !hasBCP47(langs, "aa") || hasBCP47(langs, "en-US") {
+		t.Errorf("Error: LanguagesWithoutWinID() should have aa but no en-US")
+	}
+	for _, lang := range langs {
+		if lang.IsValidWinID() {
+			t.Errorf("Error: LanguagesWithoutWinID() should not have '%s' (%s)", lang.BCP47, lang.WinID)
+		}
+	}
+	if len(langs)+len(lp.Where(func(lang slang.Lang) bool { return lang.IsValidWinID() })) != lp.Len() {
+		t.Errorf("Error: LanguagesWithoutWinID() should have all languages without a valid Windows language ID")
+	}
+}
+
+func TestLanguagesWithoutLCID(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	lp.AddCustom(slang.Lang{BCP47: "kg-SU", MSLCID: slang.NeutralLCID})
+
+	langs := lp.LanguagesWithoutLCID()
+	if len(langs) != 1 || langs[0].BCP47 != "kg-SU" {
+		t.Errorf("Error: LanguagesWithoutLCID() should only have kg-SU, got %v", langs)
+	}
+}
+
+func TestLanguagesWithUnspecifiedLCID(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	lp.AddCustom(slang.Lang{BCP47: "kg-SU", MSLCID: slang.NeutralLCID})
+
+	langs := lp.LanguagesWithUnspecifiedLCID()
+	if !hasBCP47(langs, "aa") || hasBCP47(langs, "kg-SU") || hasBCP47(langs, "en-US") {
+		t.Errorf("Error: LanguagesWithUnspecifiedLCID() should have aa but no kg-SU or en-US")
+	}
+}
+
+func TestNewParserMatchesCSV(t *testing.T) {
+	f, err := os.Open("langdb.csv")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer f.Close()
+
+	fromCSV, err := slang.NewParserFromReader(f)
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	if !reflect.DeepEqual(lp.Entries(), fromCSV.Entries()) {
+		t.Errorf("Error: generated database differs from langdb.csv, run go generate")
+	}
+}
+
+func TestParseCSVFunc(t *testing.T) {
+	csv := "id,name,location,lcid,bcp47,winid,iso639_1,iso639_2,iso639_3\n" +
+		"1,Klingon,Star Trek Universe,0x0000,kg-SU,KLI,kg,tlh,tlh\n" +
+		"2,Dothraki,Essos,0x1000,dt-ES,ZZZ,dt,dth,dth\n" +
+		"3,Broken,,0xZZZZ,br-OK,ZZZ,,,\n"
+
+	tags := []string{}
+	err := slang.ParseCSVFunc(strings.NewReader(csv), func(lang slang.Lang) error {
+		tags = append(tags, lang.BCP47)
+		return nil
+	})
+	if !errors.Is(err, slang.ErrInvalidLCID) || !reflect.DeepEqual(tags, []string{"kg-SU", "dt-ES"}) {
+		t.Errorf("Error: ParseCSVFunc should stream kg-SU and dt-ES before ErrInvalidLCID, got %v and %v", tags, err)
+	}
+
+	stop := errors.New("stop")
+	count := 0
+	err = slang.ParseCSVFunc(strings.NewReader(csv), func(lang slang.Lang) error {
+		count++
+		return stop
+	})
+	if err != stop || count != 1 {
+		t.Errorf("Error: ParseCSVFunc should stop at the error of fn, got %v after %d languages", err, count)
+	}
+
+	f, err := os.Open("langdb.csv")
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer f.Close()
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	count = 0
+	if err := slang.ParseCSVFunc(f, func(slang.Lang) error { count++; return nil }); err != nil || count != lp.Len() {
+		t.Errorf("Error: ParseCSVFunc(langdb.csv) should stream all languages, got %d and %v", count, err)
+	}
+}
+
+func TestLangGobRoundTrip(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	langs := lp.FindAllByBCP47("*")
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(langs); err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	var decoded []slang.Lang
+	if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, langs) {
+		t.Errorf("Error: languages should be the same after gob round trip")
+	}
+}
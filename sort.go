@@ -0,0 +1,53 @@
+package slang
+
+import "sort"
+
+// SortKey is the ordering used by SortResults.
+type SortKey int
+
+const (
+	// SortByBCP47 orders languages by BCP47 tag length, then alphabetically by BCP47 tag.
+	// Languages sharing the same BCP47 tag are ordered by Name, then Windows language ID, then MSLCID,
+	// so the order does not depend on the order in the database.
+	//
+	// This is the order used by the FindAll* methods, and is the default SortKey.
+	SortByBCP47 SortKey = iota
+
+	// SortByName orders languages alphabetically by Name.
+	SortByName
+
+	// SortByMSLCID orders languages by Microsoft's LCID.
+	SortByMSLCID
+
+	// SortByISOCode orders languages alphabetically by ISO 639-3 code.
+	SortByISOCode
+)
+
+// SortResults sorts the languages in place, such as the results returned by the Find* methods.
+//
+// Languages which are equal by the SortKey are ordered by SortByBCP47.
+func SortResults(langs []Lang, by SortKey) {
+	sort.SliceStable(langs, func(i, j int) bool {
+		return lessBy(langs[i], langs[j], by)
+	})
+}
+
+func lessBy(a, b Lang, by SortKey) bool {
+	switch {
+	case by == SortByName && a.Name != b.Name:
+		return a.Name < b.Name
+	case by == SortByMSLCID && a.MSLCID != b.MSLCID:
+		return a.MSLCID < b.MSLCID
+	case by == SortByISOCode && a.ISO639Set3 != b.ISO639Set3:
+		return a.ISO639Set3 < b.ISO639Set3
+	case len(a.BCP47) != len(b.BCP47):
+		return len(a.BCP47) < len(b.BCP47)
+	case a.BCP47 != b.BCP47:
+		return a.BCP47 < b.BCP47
+	case a.Name != b.Name:
+		return a.Name < b.Name
+	case a.WinID != b.WinID:
+		return a.WinID < b.WinID
+	}
+	return a.MSLCID < b.MSLCID
+}
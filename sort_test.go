@@ -0,0 +1,53 @@
+package slang_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestSortResults(t *testing.T) {
+	langs := []slang.Lang{
+		{Name: "Chinese", BCP47: "zh-TW", MSLCID: 0x0404, ISO639Set3: "zho"},
+		{Name: "English", BCP47: "en", MSLCID: 0x0009, ISO639Set3: "eng"},
+		{Name: "Chinese", BCP47: "zh", MSLCID: 0x7804, ISO639Set3: "zho"},
+		{Name: "Afrikaans", BCP47: "af-ZA", MSLCID: 0x0436, ISO639Set3: "afr"},
+	}
+	bcp47 := func(langs []slang.Lang) []string {
+		return slang.Select(langs, func(lang slang.Lang) string { return lang.BCP47 })
+	}
+
+	tests := map[slang.SortKey][]string{
+		slang.SortByBCP47:   {"en", "zh", "af-ZA", "zh-TW"},
+		slang.SortByName:    {"af-ZA", "zh", "zh-TW", "en"},
+		slang.SortByMSLCID:  {"en", "zh-TW", "af-ZA", "zh"},
+		slang.SortByISOCode: {"af-ZA", "en", "zh", "zh-TW"},
+	}
+	for by, expected := range tests {
+		sorted := append([]slang.Lang{}, langs...)
+		slang.SortResults(sorted, by)
+		if actual := bcp47(sorted); !reflect.DeepEqual(actual, expected) {
+			t.Errorf("Error: SortResults(%d) should be %v, got %v", by, expected, actual)
+		}
+	}
+}
+
+func TestSortResultsSameBCP47(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	lp.AddCustom(slang.Lang{Name: "Klingon", BCP47: "tlh", WinID: "TLH", MSLCID: 0x1000, ISO639Set3: "tlh"}).
+		AddCustom(slang.Lang{Name: "Klingon", BCP47: "tlh", WinID: "KLI", MSLCID: 0x1000, ISO639Set3: "tlh"}).
+		AddCustom(slang.Lang{Name: "Klingon", BCP47: "tlh", WinID: "KLI", MSLCID: 0x0C00, ISO639Set3: "tlh"}).
+		AddCustom(slang.Lang{Name: "Hol", BCP47: "tlh", WinID: "ZZZ", MSLCID: 0x1000, ISO639Set3: "tlh"})
+
+	expected := []string{"Hol ZZZ 0x1000", "Klingon KLI 0x0C00", "Klingon KLI 0x1000", "Klingon TLH 0x1000"}
+	key := func(lang slang.Lang) string { return fmt.Sprintf("%s %s 0x%04X", lang.Name, lang.WinID, lang.MSLCID) }
+	if actual := slang.Select(lp.FindAllByISO639Set3("tlh"), key); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Error: FindAllByISO639Set3(tlh) should be %v, got %v", expected, actual)
+	}
+}
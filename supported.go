@@ -0,0 +1,173 @@
+package slang
+
+// Confidence is how well a supported language matches the requested one.
+type Confidence int
+
+const (
+	// ConfidenceNo means the languages are not related, so the supported language is only a default.
+	ConfidenceNo Confidence = iota
+
+	// ConfidenceLow means the languages are the same, but written in different scripts (example: zh-TW for zh-Hans).
+	ConfidenceLow
+
+	// ConfidenceHigh means the languages are the same and written in the same script,
+	// but the tags are different (example: en-GB for en-US, or cmn for zh).
+	ConfidenceHigh
+
+	// ConfidenceExact means the tags are the same, apart from casing, separators and extensions.
+	ConfidenceExact
+)
+
+// String returns the name of the confidence (example: "Exact" or "No").
+func (c Confidence) String() string {
+	switch c {
+	case ConfidenceLow:
+		return "Low"
+	case ConfidenceHigh:
+		return "High"
+	case ConfidenceExact:
+		return "Exact"
+	}
+	return "No"
+}
+
+// Supported matches requested tags against a fixed list of supported languages,
+// such as the languages an application has translations for.
+//
+// Supported is safe for concurrent use by multiple goroutines.
+type Supported struct {
+	parser *LangParser
+	tags   []string
+	parts  []matchParts
+}
+
+// matchParts is a BCP47 tag prepared for matching.
+type matchParts struct {
+	// Tag without extensions, in lower case with dash (-) as separator.
+	tag string
+
+	// Primary language subtag of the language in the database (example: "zh" for "cmn").
+	language string
+
+	// Script and region subtags, filled with the likely ones when missing.
+	script string
+	region string
+}
+
+// NewSupported creates a Supported for the given BCP47 tags. The first tag is the default language,
+// returned when no supported language matches.
+//
+// Tags are case insensitive and support both dash (-) and underscore (_) as separator.
+// They are resolved against the languages of the parser when Supported is created.
+func (p *LangParser) NewSupported(tags ...string) *Supported {
+	s := &Supported{parser: p}
+	for _, tag := range tags {
+		s.tags = append(s.tags, tag)
+		s.parts = append(s.parts, p.matchParts(tag))
+	}
+	return s
+}
+
+// Match returns the supported language best matching the tag, and the confidence of the match.
+//
+// The tag is scored against every supported tag by MatchConfidence, and the highest score wins.
+// Codes the database knows are compared as the same language (example: "cmn" and "iw" are matched
+// as "zh" and "he"), and missing scripts and regions are filled by AddLikelySubtags (example: "zh-TW"
+// is written in Hant). If multiple supported tags have the same score, the first one is picked.
+//
+// If no supported language matches, it will return the default language with ConfidenceNo.
+// If there is no supported language, or the supported language is not in the database, it will return nil.
+func (s *Supported) Match(tag string) (*Lang, Confidence) {
+	if len(s.tags) == 0 {
+		return nil, ConfidenceNo
+	}
+
+	want := s.parser.matchParts(tag)
+	best, bestScore := 0, 0
+	for i, have := range s.parts {
+		if score := want.score(have); score > bestScore {
+			best, bestScore = i, score
+		}
+	}
+	return s.parser.FindByBCP47(s.tags[best]), confidenceOf(bestScore)
+}
+
+// Scores of MatchConfidence.
+const (
+	scoreNone            = 0
+	scoreLanguage        = 1
+	scoreScript          = 2
+	scoreScriptAndRegion = 3
+	scoreExact           = 4
+)
+
+// MatchConfidence scores how close the tag have is to the tag want, where higher means closer.
+// It is the score used by Supported to rank the supported languages.
+//
+// The languages and scripts are compared as in Supported.Match, and regions are filled by AddLikelySubtags too.
+// The scores are stable:
+//
+//	4  Exact tag, apart from casing, separators and extensions (example: en-US and en_us).
+//	3  Same language, script and region (example: en and en-US, or zh-TW and zh-Hant).
+//	2  Same language and script, but different region (example: en-GB and en-US).
+//	1  Same language, but different script (example: zh-Hans and zh-Hant).
+//	0  Different languages, or either tag is empty.
+func (p *LangParser) MatchConfidence(want, have string) int {
+	return p.matchParts(want).score(p.matchParts(have))
+}
+
+func (p *LangParser) matchParts(tag string) matchParts {
+	base := trimExtensions(tag)
+	if preferred, ok := grandfathered[base]; ok {
+		base = stdBCP47Tag(preferred)
+	}
+	parts := splitTag(base)
+	if parts.language == "" {
+		return matchParts{}
+	}
+
+	language := parts.language
+	if lang := p.FindByISOCode(language); lang != nil {
+		language = splitTag(lang.BCP47).language
+	}
+	likely := language
+	for _, subtag := range []string{parts.script, parts.region} {
+		if subtag != "" {
+			likely += "-" + subtag
+		}
+	}
+	likely = AddLikelySubtags(likely)
+	return matchParts{tag: base, language: language, script: ScriptOf(likely), region: RegionOf(likely)}
+}
+
+// score returns the score of MatchConfidence. Unknown scripts and regions are not treated as different.
+func (want matchParts) score(have matchParts) int {
+	switch {
+	case want.language == "" || want.language != have.language:
+		return scoreNone
+	case want.tag == have.tag:
+		return scoreExact
+	case differ(want.script, have.script):
+		return scoreLanguage
+	case differ(want.region, have.region):
+		return scoreScript
+	}
+	return scoreScriptAndRegion
+}
+
+// differ reports whether both subtags are known and different.
+func differ(a, b string) bool {
+	return a != "" && b != "" && a != b
+}
+
+func confidenceOf(score int) Confidence {
+	switch score {
+	case scoreExact:
+		return ConfidenceExact
+	case scoreScriptAndRegion, scoreScript:
+		return ConfidenceHigh
+	case scoreLanguage:
+		return ConfidenceLow
+	}
+	return ConfidenceNo
+}
@@ -0,0 +1,77 @@
+package slang_test
+
+import (
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestSupportedMatch(t *testing.T) {
+	s := slang.NewSupported("en-US", "en-GB", "zh-Hans", "zh-Hant", "fr", "he")
+
+	tests := []struct {
+		tag        string
+		bcp47      string
+		confidence slang.Confidence
+	}{
+		{"en-US", "en-US", slang.ConfidenceExact},
+		{"EN_gb", "en-GB", slang.ConfidenceExact},
+		{"en-AU", "en-US", slang.ConfidenceHigh},
+		{"en", "en-US", slang.ConfidenceHigh},
+		{"en-GB-u-ca-gregory", "en-GB", slang.ConfidenceExact},
+		{"zh-TW", "zh-Hant", slang.ConfidenceHigh},
+		{"zh-CN", "zh-Hans", slang.ConfidenceHigh},
+		{"cmn", "zh-Hans", slang.ConfidenceHigh},
+		{"zh-Latn", "zh-Hans", slang.ConfidenceLow},
+		{"fr-CA", "fr", slang.ConfidenceHigh},
+		{"iw", "he", slang.ConfidenceHigh},
+		{"ja", "en-US", slang.ConfidenceNo},
+		{"", "en-US", slang.ConfidenceNo},
+	}
+	for _, test := range tests {
+		lang, confidence := s.Match(test.tag)
+		if lang == nil || lang.BCP47 != test.bcp47 || confidence != test.confidence {
+			t.Errorf("Error: Match(%s) should be '%s' with %v, got %v with %v", test.tag, test.bcp47, test.confidence, lang, confidence)
+		}
+	}
+}
+
+func TestSupportedEmpty(t *testing.T) {
+	if lang, confidence := slang.NewSupported().Match("en"); lang != nil || confidence != slang.ConfidenceNo {
+		t.Errorf("Error: Match(en) without supported languages should be nil with No, got %v with %v", lang, confidence)
+	}
+}
+
+func TestConfidenceString(t *testing.T) {
+	for confidence, name := range map[slang.Confidence]string{
+		slang.ConfidenceNo:    "No",
+		slang.ConfidenceLow:   "Low",
+		slang.ConfidenceHigh:  "High",
+		slang.ConfidenceExact: "Exact",
+	} {
+		if confidence.String() != name {
+			t.Errorf("Error: String() of %d should be '%s', got '%s'", confidence, name, confidence)
+		}
+	}
+}
+
+func TestMatchConfidence(t *testing.T) {
+	tests := []struct {
+		want, have string
+		score      int
+	}{
+		{"en-US", "en_us", 4},
+		{"en", "en-US", 3},
+		{"zh-TW", "zh-Hant", 3},
+		{"en-GB", "en-US", 2},
+		{"cmn", "zh-Hans", 3},
+		{"zh-Hans", "zh-Hant", 1},
+		{"en", "fr", 0},
+		{"", "en", 0},
+	}
+	for _, test := range tests {
+		if score := slang.MatchConfidence(test.want, test.have); score != test.score {
+			t.Errorf("Error: MatchConfidence(%s, %s) should be %d, got %d", test.want, test.have, test.score, score)
+		}
+	}
+}
@@ -0,0 +1,305 @@
+package slang
+
+// timezones maps lower-cased ISO 3166-1 alpha-2 codes to the IANA time zones of the regions, generated from zone.tab
+// of the IANA time zone database. Zones of a region are in the order of zone.tab, which starts from the most populated area.
+var timezones = map[string][]string{
+	"ad": {"Europe/Andorra"},
+	"ae": {"Asia/Dubai"},
+	"af": {"Asia/Kabul"},
+	"ag": {"America/Antigua"},
+	"ai": {"America/Anguilla"},
+	"al": {"Europe/Tirane"},
+	"am": {"Asia/Yerevan"},
+	"ao": {"Africa/Luanda"},
+	"aq": {
+		"Antarctica/McMurdo", "Antarctica/Casey", "Antarctica/Davis", "Antarctica/DumontDUrville", "Antarctica/Mawson",
+		"Antarctica/Palmer", "Antarctica/Rothera", "Antarctica/Syowa", "Antarctica/Troll", "Antarctica/Vostok",
+	},
+	"ar": {
+		"America/Argentina/Buenos_Aires", "America/Argentina/Cordoba", "America/Argentina/Salta",
+		"America/Argentina/Jujuy", "America/Argentina/Tucuman", "America/Argentina/Catamarca",
+		"America/Argentina/La_Rioja", "America/Argentina/San_Juan", "America/Argentina/Mendoza",
+		"America/Argentina/San_Luis", "America/Argentina/Rio_Gallegos", "America/Argentina/Ushuaia",
+	},
+	"as": {"Pacific/Pago_Pago"},
+	"at": {"Europe/Vienna"},
+	"au": {
+		"Australia/Lord_Howe", "Antarctica/Macquarie", "Australia/Hobart", "Australia/Melbourne", "Australia/Sydney",
+		"Australia/Broken_Hill", "Australia/Brisbane", "Australia/Lindeman", "Australia/Adelaide", "Australia/Darwin",
+		"Australia/Perth", "Australia/Eucla",
+	},
+	"aw": {"America/Aruba"},
+	"ax": {"Europe/Mariehamn"},
+	"az": {"Asia/Baku"},
+	"ba": {"Europe/Sarajevo"},
+	"bb": {"America/Barbados"},
+	"bd": {"Asia/Dhaka"},
+	"be": {"Europe/Brussels"},
+	"bf": {"Africa/Ouagadougou"},
+	"bg": {"Europe/Sofia"},
+	"bh": {"Asia/Bahrain"},
+	"bi": {"Africa/Bujumbura"},
+	"bj": {"Africa/Porto-Novo"},
+	"bl": {"America/St_Barthelemy"},
+	"bm": {"Atlantic/Bermuda"},
+	"bn": {"Asia/Brunei"},
+	"bo": {"America/La_Paz"},
+	"bq": {"America/Kralendijk"},
+	"br": {
+		"America/Noronha", "America/Belem", "America/Fortaleza", "America/Recife", "America/Araguaina", "America/Maceio",
+		"America/Bahia", "America/Sao_Paulo", "America/Campo_Grande", "America/Cuiaba", "America/Santarem",
+		"America/Porto_Velho", "America/Boa_Vista", "America/Manaus", "America/Eirunepe", "America/Rio_Branco",
+	},
+	"bs": {"America/Nassau"},
+	"bt": {"Asia/Thimphu"},
+	"bw": {"Africa/Gaborone"},
+	"by": {"Europe/Minsk"},
+	"bz": {"America/Belize"},
+	"ca": {
+		"America/St_Johns", "America/Halifax", "America/Glace_Bay", "America/Moncton", "America/Goose_Bay",
+		"America/Blanc-Sablon", "America/Toronto", "America/Iqaluit", "America/Atikokan", "America/Winnipeg",
+		"America/Resolute", "America/Rankin_Inlet", "America/Regina", "America/Swift_Current", "America/Edmonton",
+		"America/Cambridge_Bay", "America/Inuvik", "America/Creston", "America/Dawson_Creek", "America/Fort_Nelson",
+		"America/Whitehorse", "America/Dawson", "America/Vancouver",
+	},
+	"cc": {"Indian/Cocos"},
+	"cd": {"Africa/Kinshasa", "Africa/Lubumbashi"},
+	"cf": {"Africa/Bangui"},
+	"cg": {"Africa/Brazzaville"},
+	"ch": {"Europe/Zurich"},
+	"ci": {"Africa/Abidjan"},
+	"ck": {"Pacific/Rarotonga"},
+	"cl": {"America/Santiago", "America/Coyhaique", "America/Punta_Arenas", "Pacific/Easter"},
+	"cm": {"Africa/Douala"},
+	"cn": {"Asia/Shanghai", "Asia/Urumqi"},
+	"co": {"America/Bogota"},
+	"cr": {"America/Costa_Rica"},
+	"cu": {"America/Havana"},
+	"cv": {"Atlantic/Cape_Verde"},
+	"cw": {"America/Curacao"},
+	"cx": {"Indian/Christmas"},
+	"cy": {"Asia/Nicosia", "Asia/Famagusta"},
+	"cz": {"Europe/Prague"},
+	"de": {"Europe/Berlin", "Europe/Busingen"},
+	"dj": {"Africa/Djibouti"},
+	"dk": {"Europe/Copenhagen"},
+	"dm": {"America/Dominica"},
+	"do": {"America/Santo_Domingo"},
+	"dz": {"Africa/Algiers"},
+	"ec": {"America/Guayaquil", "Pacific/Galapagos"},
+	"ee": {"Europe/Tallinn"},
+	"eg": {"Africa/Cairo"},
+	"eh": {"Africa/El_Aaiun"},
+	"er": {"Africa/Asmara"},
+	"es": {"Europe/Madrid", "Africa/Ceuta", "Atlantic/Canary"},
+	"et": {"Africa/Addis_Ababa"},
+	"fi": {"Europe/Helsinki"},
+	"fj": {"Pacific/Fiji"},
+	"fk": {"Atlantic/Stanley"},
+	"fm": {"Pacific/Chuuk", "Pacific/Pohnpei", "Pacific/Kosrae"},
+	"fo": {"Atlantic/Faroe"},
+	"fr": {"Europe/Paris"},
+	"ga": {"Africa/Libreville"},
+	"gb": {"Europe/London"},
+	"gd": {"America/Grenada"},
+	"ge": {"Asia/Tbilisi"},
+	"gf": {"America/Cayenne"},
+	"gg": {"Europe/Guernsey"},
+	"gh": {"Africa/Accra"},
+	"gi": {"Europe/Gibraltar"},
+	"gl": {"America/Nuuk", "America/Danmarkshavn", "America/Scoresbysund", "America/Thule"},
+	"gm": {"Africa/Banjul"},
+	"gn": {"Africa/Conakry"},
+	"gp": {"America/Guadeloupe"},
+	"gq": {"Africa/Malabo"},
+	"gr": {"Europe/Athens"},
+	"gs": {"Atlantic/South_Georgia"},
+	"gt": {"America/Guatemala"},
+	"gu": {"Pacific/Guam"},
+	"gw": {"Africa/Bissau"},
+	"gy": {"America/Guyana"},
+	"hk": {"Asia/Hong_Kong"},
+	"hn": {"America/Tegucigalpa"},
+	"hr": {"Europe/Zagreb"},
+	"ht": {"America/Port-au-Prince"},
+	"hu": {"Europe/Budapest"},
+	"id": {"Asia/Jakarta", "Asia/Pontianak", "Asia/Makassar", "Asia/Jayapura"},
+	"ie": {"Europe/Dublin"},
+	"il": {"Asia/Jerusalem"},
+	"im": {"Europe/Isle_of_Man"},
+	"in": {"Asia/Kolkata"},
+	"io": {"Indian/Chagos"},
+	"iq": {"Asia/Baghdad"},
+	"ir": {"Asia/Tehran"},
+	"is": {"Atlantic/Reykjavik"},
+	"it": {"Europe/Rome"},
+	"je": {"Europe/Jersey"},
+	"jm": {"America/Jamaica"},
+	"jo": {"Asia/Amman"},
+	"jp": {"Asia/Tokyo"},
+	"ke": {"Africa/Nairobi"},
+	"kg": {"Asia/Bishkek"},
+	"kh": {"Asia/Phnom_Penh"},
+	"ki": {"Pacific/Tarawa", "Pacific/Kanton", "Pacific/Kiritimati"},
+	"km": {"Indian/Comoro"},
+	"kn": {"America/St_Kitts"},
+	"kp": {"Asia/Pyongyang"},
+	"kr": {"Asia/Seoul"},
+	"kw": {"Asia/Kuwait"},
+	"ky": {"America/Cayman"},
+	"kz": {"Asia/Almaty", "Asia/Qyzylorda", "Asia/Qostanay", "Asia/Aqtobe", "Asia/Aqtau", "Asia/Atyrau", "Asia/Oral"},
+	"la": {"Asia/Vientiane"},
+	"lb": {"Asia/Beirut"},
+	"lc": {"America/St_Lucia"},
+	"li": {"Europe/Vaduz"},
+	"lk": {"Asia/Colombo"},
+	"lr": {"Africa/Monrovia"},
+	"ls": {"Africa/Maseru"},
+	"lt": {"Europe/Vilnius"},
+	"lu": {"Europe/Luxembourg"},
+	"lv": {"Europe/Riga"},
+	"ly": {"Africa/Tripoli"},
+	"ma": {"Africa/Casablanca"},
+	"mc": {"Europe/Monaco"},
+	"md": {"Europe/Chisinau"},
+	"me": {"Europe/Podgorica"},
+	"mf": {"America/Marigot"},
+	"mg": {"Indian/Antananarivo"},
+	"mh": {"Pacific/Majuro", "Pacific/Kwajalein"},
+	"mk": {"Europe/Skopje"},
+	"ml": {"Africa/Bamako"},
+	"mm": {"Asia/Yangon"},
+	"mn": {"Asia/Ulaanbaatar", "Asia/Hovd"},
+	"mo": {"Asia/Macau"},
+	"mp": {"Pacific/Saipan"},
+	"mq": {"America/Martinique"},
+	"mr": {"Africa/Nouakchott"},
+	"ms": {"America/Montserrat"},
+	"mt": {"Europe/Malta"},
+	"mu": {"Indian/Mauritius"},
+	"mv": {"Indian/Maldives"},
+	"mw": {"Africa/Blantyre"},
+	"mx": {
+		"America/Mexico_City", "America/Cancun", "America/Merida", "America/Monterrey", "America/Matamoros",
+		"America/Chihuahua", "America/Ciudad_Juarez", "America/Ojinaga", "America/Mazatlan", "America/Bahia_Banderas",
+		"America/Hermosillo", "America/Tijuana",
+	},
+	"my": {"Asia/Kuala_Lumpur", "Asia/Kuching"},
+	"mz": {"Africa/Maputo"},
+	"na": {"Africa/Windhoek"},
+	"nc": {"Pacific/Noumea"},
+	"ne": {"Africa/Niamey"},
+	"nf": {"Pacific/Norfolk"},
+	"ng": {"Africa/Lagos"},
+	"ni": {"America/Managua"},
+	"nl": {"Europe/Amsterdam"},
+	"no": {"Europe/Oslo"},
+	"np": {"Asia/Kathmandu"},
+	"nr": {"Pacific/Nauru"},
+	"nu": {"Pacific/Niue"},
+	"nz": {"Pacific/Auckland", "Pacific/Chatham"},
+	"om": {"Asia/Muscat"},
+	"pa": {"America/Panama"},
+	"pe": {"America/Lima"},
+	"pf": {"Pacific/Tahiti", "Pacific/Marquesas", "Pacific/Gambier"},
+	"pg": {"Pacific/Port_Moresby", "Pacific/Bougainville"},
+	"ph": {"Asia/Manila"},
+	"pk": {"Asia/Karachi"},
+	"pl": {"Europe/Warsaw"},
+	"pm": {"America/Miquelon"},
+	"pn": {"Pacific/Pitcairn"},
+	"pr": {"America/Puerto_Rico"},
+	"ps": {"Asia/Gaza", "Asia/Hebron"},
+	"pt": {"Europe/Lisbon", "Atlantic/Madeira", "Atlantic/Azores"},
+	"pw": {"Pacific/Palau"},
+	"py": {"America/Asuncion"},
+	"qa": {"Asia/Qatar"},
+	"re": {"Indian/Reunion"},
+	"ro": {"Europe/Bucharest"},
+	"rs": {"Europe/Belgrade"},
+	"ru": {
+		"Europe/Kaliningrad", "Europe/Moscow", "Europe/Kirov", "Europe/Volgograd", "Europe/Astrakhan", "Europe/Saratov",
+		"Europe/Ulyanovsk", "Europe/Samara", "Asia/Yekaterinburg", "Asia/Omsk", "Asia/Novosibirsk", "Asia/Barnaul",
+		"Asia/Tomsk", "Asia/Novokuznetsk", "Asia/Krasnoyarsk", "Asia/Irkutsk", "Asia/Chita", "Asia/Yakutsk",
+		"Asia/Khandyga", "Asia/Vladivostok", "Asia/Ust-Nera", "Asia/Magadan", "Asia/Sakhalin", "Asia/Srednekolymsk",
+		"Asia/Kamchatka", "Asia/Anadyr",
+	},
+	"rw": {"Africa/Kigali"},
+	"sa": {"Asia/Riyadh"},
+	"sb": {"Pacific/Guadalcanal"},
+	"sc": {"Indian/Mahe"},
+	"sd": {"Africa/Khartoum"},
+	"se": {"Europe/Stockholm"},
+	"sg": {"Asia/Singapore"},
+	"sh": {"Atlantic/St_Helena"},
+	"si": {"Europe/Ljubljana"},
+	"sj": {"Arctic/Longyearbyen"},
+	"sk": {"Europe/Bratislava"},
+	"sl": {"Africa/Freetown"},
+	"sm": {"Europe/San_Marino"},
+	"sn": {"Africa/Dakar"},
+	"so": {"Africa/Mogadishu"},
+	"sr": {"America/Paramaribo"},
+	"ss": {"Africa/Juba"},
+	"st": {"Africa/Sao_Tome"},
+	"sv": {"America/El_Salvador"},
+	"sx": {"America/Lower_Princes"},
+	"sy": {"Asia/Damascus"},
+	"sz": {"Africa/Mbabane"},
+	"tc": {"America/Grand_Turk"},
+	"td": {"Africa/Ndjamena"},
+	"tf": {"Indian/Kerguelen"},
+	"tg": {"Africa/Lome"},
+	"th": {"Asia/Bangkok"},
+	"tj": {"Asia/Dushanbe"},
+	"tk": {"Pacific/Fakaofo"},
+	"tl": {"Asia/Dili"},
+	"tm": {"Asia/Ashgabat"},
+	"tn": {"Africa/Tunis"},
+	"to": {"Pacific/Tongatapu"},
+	"tr": {"Europe/Istanbul"},
+	"tt": {"America/Port_of_Spain"},
+	"tv": {"Pacific/Funafuti"},
+	"tw": {"Asia/Taipei"},
+	"tz": {"Africa/Dar_es_Salaam"},
+	"ua": {"Europe/Simferopol", "Europe/Kyiv"},
+	"ug": {"Africa/Kampala"},
+	"um": {"Pacific/Midway", "Pacific/Wake"},
+	"us": {
+		"America/New_York", "America/Detroit", "America/Kentucky/Louisville", "America/Kentucky/Monticello",
+		"America/Indiana/Indianapolis", "America/Indiana/Vincennes", "America/Indiana/Winamac", "America/Indiana/Marengo",
+		"America/Indiana/Petersburg", "America/Indiana/Vevay", "America/Chicago", "America/Indiana/Tell_City",
+		"America/Indiana/Knox", "America/Menominee", "America/North_Dakota/Center", "America/North_Dakota/New_Salem",
+		"America/North_Dakota/Beulah", "America/Denver", "America/Boise", "America/Phoenix", "America/Los_Angeles",
+		"America/Anchorage", "America/Juneau", "America/Sitka", "America/Metlakatla", "America/Yakutat", "America/Nome",
+		"America/Adak", "Pacific/Honolulu",
+	},
+	"uy": {"America/Montevideo"},
+	"uz": {"Asia/Samarkand", "Asia/Tashkent"},
+	"va": {"Europe/Vatican"},
+	"vc": {"America/St_Vincent"},
+	"ve": {"America/Caracas"},
+	"vg": {"America/Tortola"},
+	"vi": {"America/St_Thomas"},
+	"vn": {"Asia/Ho_Chi_Minh"},
+	"vu": {"Pacific/Efate"},
+	"wf": {"Pacific/Wallis"},
+	"ws": {"Pacific/Apia"},
+	"ye": {"Asia/Aden"},
+	"yt": {"Indian/Mayotte"},
+	"za": {"Africa/Johannesburg"},
+	"zm": {"Africa/Lusaka"},
+	"zw": {"Africa/Harare"},
+}
+
+// Timezones returns the IANA time zones used in the region (example: ["Asia/Tokyo"] for JP),
+// with the most populated one first, so it can be used as the default selection.
+//
+// The region can be an ISO 3166-1 alpha-2, alpha-3 or numeric code (example: "US", "USA" or "840"), case insensitive.
+// Use RegionOf to get the region of a BCP47 tag.
+//
+// The result is only a hint from an embedded table, not authoritative: time zones change over time,
+// and the zones do not tell which one a user is in. If the region is unknown, it will return an empty slice.
+func Timezones(region string) []string {
+	return append([]string{}, timezones[toLowerASCII(CountryAlpha2(region))]...)
+}
@@ -0,0 +1,35 @@
+package slang_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestTimezones(t *testing.T) {
+	for region, expected := range map[string][]string{
+		"JP":  {"Asia/Tokyo"},
+		"jpn": {"Asia/Tokyo"},
+		"CN":  {"Asia/Shanghai", "Asia/Urumqi"},
+		"ZZ":  {},
+		"":    {},
+	} {
+		if zones := slang.Timezones(region); !reflect.DeepEqual(zones, expected) {
+			t.Errorf("Error: Timezones(%s) should be %v, got %v", region, expected, zones)
+		}
+	}
+
+	if zones := slang.Timezones("ZZ"); zones == nil {
+		t.Errorf("Error: Timezones(ZZ) should be an empty slice, got nil")
+	}
+
+	zones := slang.Timezones("US")
+	if len(zones) < 2 || zones[0] != "America/New_York" {
+		t.Errorf("Error: Timezones(US) should start with 'America/New_York', got %v", zones)
+	}
+	zones[0] = "modified"
+	if zones := slang.Timezones("US"); zones[0] != "America/New_York" {
+		t.Errorf("Error: modifying the result of Timezones should not affect the table, got %v", zones)
+	}
+}
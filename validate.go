@@ -0,0 +1,67 @@
+package slang
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Validate checks every language of the parser, and returns all problems found rather than stopping at the first.
+//
+// The checks are:
+//  1. BCP47 tag is well-formed, see ValidateBCP47.
+//  2. ISO 639-3 code is not empty.
+//  3. Windows language ID is valid, or is the "ZZZ" sentinel for languages without one.
+//  4. No language has the same BCP47 tag and ISO 639-3 code as another one (case insensitive).
+//     A BCP47 tag alone may be shared, as sub-languages of a macrolanguage use the tag of the macrolanguage.
+//
+// MSLCID is always in range, as it is stored as uint32.
+//
+// Errors wrap ErrInvalidBCP47, ErrInvalidWinID or ErrInvalidEntry, and name the entry by its 1-based position,
+// which is the id column of WriteCSV. If the database is consistent, it will return an empty slice.
+func (p *LangParser) Validate() []error {
+	errs := []error{}
+	seen := map[[2]string]int{}
+	id := 0
+	for lang := range p.All() {
+		id++
+		if err := ValidateBCP47(lang.BCP47); err != nil {
+			errs = append(errs, fmt.Errorf("entry %d: %w", id, err))
+		}
+		if lang.ISO639Set3 == "" {
+			errs = append(errs, fmt.Errorf("%w: entry %d (%s): empty ISO 639-3 code", ErrInvalidEntry, id, lang.BCP47))
+		}
+		if err := ValidateWinID(lang.WinID); err != nil && !errors.Is(err, ErrWinIDSentinel) {
+			errs = append(errs, fmt.Errorf("entry %d (%s): %w", id, lang.BCP47, err))
+		}
+
+		key := [2]string{stdBCP47Tag(lang.BCP47), toLowerASCII(lang.ISO639Set3)}
+		if first, ok := seen[key]; ok {
+			errs = append(errs, fmt.Errorf("%w: entry %d (%s): duplicate of entry %d", ErrInvalidEntry, id, lang.BCP47, first))
+		} else {
+			seen[key] = id
+		}
+	}
+	return errs
+}
+
+// Duplicates returns the languages sharing a BCP47 tag with another language, keyed by the BCP47 tag
+// in canonical casing. Case insensitive, and both dash (-) and underscore (_) are treated as the same separator.
+//
+// Languages are in database order. Note that sub-languages of a macrolanguage share the tag of the macrolanguage
+// in the embedded database (example: "zh" for cmn, yue and wuu), so they are reported too.
+// Use Deduplicate to remove exact duplicates, and RemoveByBCP47 or Upsert to resolve the others.
+//
+// If there is no duplicate, it will return an empty map.
+func (p *LangParser) Duplicates() map[string][]Lang {
+	groups := map[string][]Lang{}
+	for lang := range p.All() {
+		tag := CanonicalizeBCP47(lang.BCP47)
+		groups[tag] = append(groups[tag], lang)
+	}
+	for tag, langs := range groups {
+		if len(langs) < 2 {
+			delete(groups, tag)
+		}
+	}
+	return groups
+}
@@ -0,0 +1,72 @@
+package slang_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/baobao1270/slang"
+)
+
+func TestValidate(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	if errs := lp.Validate(); errs == nil || len(errs) != 0 {
+		t.Errorf("Error: Validate() of the embedded database should be empty, got %v", errs)
+	}
+
+	lp.AddCustom(slang.Lang{Name: "Bad Tag", BCP47: "en--US", WinID: "ZZZ", ISO639Set3: "eng"}).
+		AddCustom(slang.Lang{Name: "No ISO", BCP47: "tlh", WinID: "TLH"}).
+		AddCustom(slang.Lang{Name: "Bad WinID", BCP47: "tlh-QO", WinID: "T1H", ISO639Set3: "tlh"}).
+		AddCustom(slang.Lang{Name: "Duplicate", BCP47: "EN_us", WinID: "ENU", ISO639Set3: "ENG"})
+
+	errs := lp.Validate()
+	if len(errs) != 4 {
+		t.Errorf("Error: Validate() should return 4 errors, got %v", errs)
+		return
+	}
+	for i, target := range []error{slang.ErrInvalidBCP47, slang.ErrInvalidEntry, slang.ErrInvalidWinID, slang.ErrInvalidEntry} {
+		if !errors.Is(errs[i], target) {
+			t.Errorf("Error: Validate() error %d should be %v, got %v", i, target, errs[i])
+		}
+	}
+	if !strings.Contains(errs[3].Error(), "duplicate") {
+		t.Errorf("Error: Validate() should report duplicate, got %v", errs[3])
+	}
+}
+
+func TestDuplicates(t *testing.T) {
+	lp, err := slang.NewParserFromReader(strings.NewReader(""))
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	if duplicates := lp.Duplicates(); duplicates == nil || len(duplicates) != 0 {
+		t.Errorf("Error: Duplicates() of an empty parser should be an empty map")
+	}
+
+	lp.AddCustom(slang.Lang{Name: "Klingon", BCP47: "tlh"}).
+		AddCustom(slang.Lang{Name: "Klingon", BCP47: "tlh-QO"}).
+		AddCustom(slang.Lang{Name: "Klingon (Custom)", BCP47: "TLH_qo"}).
+		AddCustom(slang.Lang{Name: "Klingon", BCP47: "tlh-QO"})
+	duplicates := lp.Duplicates()
+	if len(duplicates) != 1 || len(duplicates["tlh-QO"]) != 3 || duplicates["tlh-QO"][1].Name != "Klingon (Custom)" {
+		t.Errorf("Error: Duplicates() should be 3 languages for 'tlh-QO', got %v", duplicates)
+	}
+
+	lp.Deduplicate()
+	if duplicates := lp.Duplicates(); len(duplicates["tlh-QO"]) != 2 {
+		t.Errorf("Error: Duplicates() should be 2 languages for 'tlh-QO' after Deduplicate, got %v", duplicates)
+	}
+
+	embedded, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	if duplicates := embedded.Duplicates(); len(duplicates["zh"]) < 2 || len(duplicates["en-US"]) != 0 {
+		t.Errorf("Error: Duplicates() of the embedded database should contain 'zh' but not 'en-US'")
+	}
+}
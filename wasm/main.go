@@ -0,0 +1,52 @@
+//go:build js && wasm
+
+// Command wasm exposes the language parser to JavaScript when built for WebAssembly.
+//
+// The embedded language database is compiled into the WebAssembly binary, so no network request is needed.
+//
+// Build it with:
+//
+//	GOOS=js GOARCH=wasm go build -o slang.wasm ./wasm
+//
+// Once the binary is running, it registers a global function slangParse(code), which returns an object with
+// the fields of the parsed language (using the same field names as the JSON encoding of slang.Lang),
+// or null if the language code cannot be parsed.
+package main
+
+import (
+	"encoding/json"
+	"syscall/js"
+
+	"github.com/baobao1270/slang"
+)
+
+func parse(this js.Value, args []js.Value) any {
+	if len(args) != 1 || args[0].Type() != js.TypeString {
+		return js.Null()
+	}
+
+	lang := slang.Parse(args[0].String())
+	if lang == nil {
+		return js.Null()
+	}
+
+	data, err := json.Marshal(lang)
+	if err != nil {
+		return js.Null()
+	}
+	fields := map[string]any{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return js.Null()
+	}
+	return js.ValueOf(fields)
+}
+
+func main() {
+	if err := slang.DefaultErr(); err != nil {
+		js.Global().Get("console").Call("error", "slang: "+err.Error())
+		return
+	}
+
+	js.Global().Set("slangParse", js.FuncOf(parse))
+	select {}
+}
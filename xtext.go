@@ -0,0 +1,17 @@
+package slang
+
+import "golang.org/x/text/language"
+
+// ToLanguageTag parses the BCP47 tag of the language into a language.Tag of golang.org/x/text.
+//
+// If the tag cannot be parsed by golang.org/x/text, it will return the error from language.Parse.
+func (lang Lang) ToLanguageTag() (language.Tag, error) {
+	return language.Parse(lang.BCP47)
+}
+
+// FromLanguageTag resolves a language.Tag of golang.org/x/text to the best matching language by FindByBCP47.
+//
+// If no value is found, it will return nil.
+func (p *LangParser) FromLanguageTag(t language.Tag) *Lang {
+	return p.FindByBCP47(t.String())
+}
@@ -0,0 +1,47 @@
+package slang_test
+
+import (
+	"testing"
+
+	"github.com/baobao1270/slang"
+	"golang.org/x/text/language"
+)
+
+func TestToLanguageTag(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	tag, err := lp.FindByBCP47("zh-TW").ToLanguageTag()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+	if tag != language.MustParse("zh-TW") {
+		t.Errorf("Error: ToLanguageTag() of zh-TW should be 'zh-TW', got '%s'", tag)
+	}
+
+	if _, err := (slang.Lang{BCP47: "invalid-tag-!"}).ToLanguageTag(); err == nil {
+		t.Errorf("Error: ToLanguageTag() of invalid tag should return error")
+	}
+}
+
+func TestFromLanguageTag(t *testing.T) {
+	lp, err := slang.NewParser()
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	if lang := lp.FromLanguageTag(language.BritishEnglish); lang == nil || lang.BCP47 != "en-GB" {
+		t.Errorf("Error: FromLanguageTag(en-GB) should be 'en-GB'")
+	}
+	if lang := lp.FromLanguageTag(language.TraditionalChinese); lang == nil || lang.BCP47 != "zh-Hant" {
+		t.Errorf("Error: FromLanguageTag(zh-Hant) should be 'zh-Hant'")
+	}
+	if lang := lp.FromLanguageTag(language.MustParse("bho-Deva-IN")); lang == nil || lang.BCP47 != "bho-Deva-IN" {
+		t.Errorf("Error: FromLanguageTag(bho-Deva-IN) should be 'bho-Deva-IN'")
+	}
+	if lang := lp.FromLanguageTag(language.Und); lang != nil {
+		t.Errorf("Error: FromLanguageTag(und) should be nil")
+	}
+}